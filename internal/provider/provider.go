@@ -89,6 +89,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
@@ -406,6 +407,8 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_accessanalyzer_policy_validation": accessanalyzer.DataSourcePolicyValidation(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -516,7 +519,9 @@ func Provider() *schema.Provider {
 			"aws_ebs_snapshot_ids":                           ec2.DataSourceEBSSnapshotIDs(),
 			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
 			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
+			"aws_ec2_client_vpn_connections":                 ec2.DataSourceClientVPNConnections(),
 			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_endpoints":                   ec2.DataSourceClientVPNEndpoints(),
 			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
 			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
 			"aws_ec2_host":                                   ec2.DataSourceHost(),
@@ -532,6 +537,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
 			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_lists":                   ec2.DataSourceManagedPrefixLists(),
 			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
@@ -541,6 +547,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_transit_gateway_multicast_domain":       ec2.DataSourceTransitGatewayMulticastDomain(),
 			"aws_ec2_transit_gateway_peering_attachment":     ec2.DataSourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_route_table":            ec2.DataSourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_routes":     ec2.DataSourceTransitGatewayRouteTableRoutes(),
 			"aws_ec2_transit_gateway_route_tables":           ec2.DataSourceTransitGatewayRouteTables(),
 			"aws_ec2_transit_gateway_vpc_attachment":         ec2.DataSourceTransitGatewayVPCAttachment(),
 			"aws_ec2_transit_gateway_vpc_attachments":        ec2.DataSourceTransitGatewayVPCAttachments(),
@@ -569,9 +576,12 @@ func Provider() *schema.Provider {
 			"aws_vpc_endpoint_service":                       ec2.DataSourceVPCEndpointService(),
 			"aws_vpc_endpoint":                               ec2.DataSourceVPCEndpoint(),
 			"aws_vpc_ipam_pool":                              ec2.DataSourceVPCIpamPool(),
+			"aws_vpc_ipam_pool_allocations":                  ec2.DataSourceVPCIpamPoolAllocations(),
+			"aws_vpc_ipam_pool_cidrs":                        ec2.DataSourceVPCIpamPoolCidrs(),
 			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceVPCIpamPreviewNextCidr(),
 			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
 			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
+			"aws_vpc_security_group_rules":                   ec2.DataSourceVPCSecurityGroupRules(),
 			"aws_vpc":                                        ec2.DataSourceVPC(),
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
@@ -633,19 +643,20 @@ func Provider() *schema.Provider {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
-			"aws_iam_group":                   iam.DataSourceGroup(),
-			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
-			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.DataSourcePolicy(),
-			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
-			"aws_iam_role":                    iam.DataSourceRole(),
-			"aws_iam_roles":                   iam.DataSourceRoles(),
-			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":         iam.DataSourceSessionContext(),
-			"aws_iam_user":                    iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
-			"aws_iam_users":                   iam.DataSourceUsers(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":     iam.DataSourceOpenIDConnectProvider(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -761,12 +772,19 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_object":         s3.DataSourceObject(),
-			"aws_s3_objects":        s3.DataSourceObjects(),
-			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
-			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
+			"aws_canonical_user_id":                              s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":                                      s3.DataSourceBucket(),
+			"aws_s3_bucket_configuration_imports":                s3.DataSourceBucketConfigurationImports(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.DataSourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_policy":                               s3.DataSourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.DataSourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.DataSourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.DataSourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.DataSourceBucketVersioning(),
+			"aws_s3_object":                                      s3.DataSourceObject(),
+			"aws_s3_objects":                                     s3.DataSourceObjects(),
+			"aws_s3_bucket_object":                               s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
+			"aws_s3_bucket_objects":                              s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -834,7 +852,8 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_analyzer":     accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
 
@@ -1188,6 +1207,7 @@ func Provider() *schema.Provider {
 			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
 			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
 			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_fast_snapshot_restore":                        ec2.ResourceEBSFastSnapshotRestore(),
 			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
 			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
 			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
@@ -1201,10 +1221,13 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
 			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_instance_state":                               ec2.ResourceInstanceState(),
+			"aws_ec2_launch_template_version":                      ec2.ResourceLaunchTemplateVersion(),
 			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                    ec2.ResourceNetworkInsightsAnalysis(),
 			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
 			"aws_ec2_serial_console_access":                        ec2.ResourceSerialConsoleAccess(),
 			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
@@ -1281,6 +1304,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                   ec2.ResourceVPCSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                  ec2.ResourceVPCSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -1422,6 +1447,13 @@ func Provider() *schema.Provider {
 			"aws_grafana_workspace":           grafana.ResourceWorkspace(),
 			"aws_grafana_license_association": grafana.ResourceLicenseAssociation(),
 
+			"aws_greengrass_core_definition":         greengrass.ResourceCoreDefinition(),
+			"aws_greengrass_function_definition":     greengrass.ResourceFunctionDefinition(),
+			"aws_greengrass_group":                   greengrass.ResourceGroup(),
+			"aws_greengrass_logger_definition":       greengrass.ResourceLoggerDefinition(),
+			"aws_greengrass_resource_definition":     greengrass.ResourceResourceDefinition(),
+			"aws_greengrass_subscription_definition": greengrass.ResourceSubscriptionDefinition(),
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
@@ -1432,32 +1464,38 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_signing_certificate":         iam.ResourceSigningCertificate(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
+			"aws_iam_access_key":                        iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                     iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":           iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                             iam.ResourceGroup(),
+			"aws_iam_group_membership":                  iam.ResourceGroupMembership(),
+			"aws_iam_group_policy":                      iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":           iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                  iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":           iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                            iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                 iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                              iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":           iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                       iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":            iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive": iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                     iam.ResourceSAMLProvider(),
+			"aws_iam_server_certificate":                iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":               iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":       iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":               iam.ResourceSigningCertificate(),
+			"aws_iam_user":                              iam.ResourceUser(),
+			"aws_iam_user_group_membership":             iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policy":                       iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":            iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_ssh_key":                      iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                iam.ResourceVirtualMFADevice(),
+
+			"aws_identitystore_group":            identitystore.ResourceGroup(),
+			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_user":             identitystore.ResourceUser(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1620,6 +1658,7 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
+			"aws_qldb_stream": qldb.ResourceStream(),
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
 			"aws_quicksight_group":            quicksight.ResourceGroup(),
@@ -1674,7 +1713,8 @@ func Provider() *schema.Provider {
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
 
-			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
+			"aws_route53domains_delegation_signer_record": route53domains.ResourceDelegationSignerRecord(),
+			"aws_route53domains_registered_domain":        route53domains.ResourceRegisteredDomain(),
 
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
@@ -1718,6 +1758,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -1728,10 +1769,12 @@ func Provider() *schema.Provider {
 			"aws_s3control_bucket":                            s3control.ResourceBucket(),
 			"aws_s3control_bucket_lifecycle_configuration":    s3control.ResourceBucketLifecycleConfiguration(),
 			"aws_s3control_bucket_policy":                     s3control.ResourceBucketPolicy(),
+			"aws_s3control_job":                               s3control.ResourceJob(),
 			"aws_s3control_multi_region_access_point":         s3control.ResourceMultiRegionAccessPoint(),
 			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
 			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
 			"aws_s3control_object_lambda_access_point_policy": s3control.ResourceObjectLambdaAccessPointPolicy(),
+			"aws_s3control_storage_lens_configuration":        s3control.ResourceStorageLensConfiguration(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -1868,7 +1911,9 @@ func Provider() *schema.Provider {
 			"aws_storagegateway_upload_buffer":           storagegateway.ResourceUploadBuffer(),
 			"aws_storagegateway_working_storage":         storagegateway.ResourceWorkingStorage(),
 
-			"aws_swf_domain": swf.ResourceDomain(),
+			"aws_swf_activity_type": swf.ResourceActivityType(),
+			"aws_swf_domain":        swf.ResourceDomain(),
+			"aws_swf_workflow_type": swf.ResourceWorkflowType(),
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
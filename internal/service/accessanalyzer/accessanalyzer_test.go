@@ -18,6 +18,11 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			"Tags":              testAccAnalyzer_Tags,
 			"Type_Organization": testAccAnalyzer_Type_Organization,
 		},
+		"ArchiveRule": {
+			"basic":      testAccArchiveRule_basic,
+			"disappears": testAccArchiveRule_disappears,
+			"update":     testAccArchiveRule_update,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,301 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const archiveRuleIDSeparator = "/"
+
+func ResourceArchiveRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArchiveRuleCreate,
+		Read:   resourceArchiveRuleRead,
+		Update: resourceArchiveRuleUpdate,
+		Delete: resourceArchiveRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"rule_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`), "must begin with a letter and contain only alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+		},
+	}
+}
+
+func resourceArchiveRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName := d.Get("analyzer_name").(string)
+	ruleName := d.Get("rule_name").(string)
+	id := ArchiveRuleCreateResourceID(analyzerName, ruleName)
+
+	input := &accessanalyzer.CreateArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Filter:       expandArchiveRuleFilter(d.Get("filter").(*schema.Set).List()),
+		RuleName:     aws.String(ruleName),
+	}
+
+	log.Printf("[DEBUG] Creating Access Analyzer Archive Rule: %s", input)
+	_, err := conn.CreateArchiveRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Access Analyzer Archive Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArchiveRuleRead(d, meta)
+}
+
+func resourceArchiveRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	rule, err := FindArchiveRule(conn, analyzerName, ruleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Access Analyzer Archive Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	d.Set("analyzer_name", analyzerName)
+	d.Set("rule_name", rule.RuleName)
+
+	if err := d.Set("filter", flattenArchiveRuleFilter(rule.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceArchiveRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("filter") {
+		input := &accessanalyzer.UpdateArchiveRuleInput{
+			AnalyzerName: aws.String(analyzerName),
+			ClientToken:  aws.String(resource.UniqueId()),
+			Filter:       expandArchiveRuleFilter(d.Get("filter").(*schema.Set).List()),
+			RuleName:     aws.String(ruleName),
+		}
+
+		log.Printf("[DEBUG] Updating Access Analyzer Archive Rule: %s", input)
+		_, err := conn.UpdateArchiveRule(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceArchiveRuleRead(d, meta)
+}
+
+func resourceArchiveRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Access Analyzer Archive Rule: (%s)", d.Id())
+	_, err = conn.DeleteArchiveRule(&accessanalyzer.DeleteArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		RuleName:     aws.String(ruleName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ArchiveRuleCreateResourceID(analyzerName, ruleName string) string {
+	parts := []string{analyzerName, ruleName}
+	id := strings.Join(parts, archiveRuleIDSeparator)
+
+	return id
+}
+
+func ArchiveRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, archiveRuleIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected analyzer-name%[2]srule-name", id, archiveRuleIDSeparator)
+}
+
+func expandArchiveRuleFilter(tfList []interface{}) map[string]*accessanalyzer.Criterion {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := make(map[string]*accessanalyzer.Criterion)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		criterion := &accessanalyzer.Criterion{}
+
+		if v, ok := tfMap["contains"].([]interface{}); ok && len(v) > 0 {
+			criterion.Contains = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["eq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Eq = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["exists"].(string); ok && v != "" {
+			exists, _ := strconv.ParseBool(v)
+			criterion.Exists = aws.Bool(exists)
+		}
+
+		if v, ok := tfMap["neq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Neq = flex.ExpandStringList(v)
+		}
+
+		apiObject[tfMap["criteria"].(string)] = criterion
+	}
+
+	return apiObject
+}
+
+func flattenArchiveRuleFilter(apiObject map[string]*accessanalyzer.Criterion) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(apiObject))
+	for k := range apiObject {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var tfList []interface{}
+
+	for _, k := range keys {
+		criterion := apiObject[k]
+
+		if criterion == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"criteria": k,
+		}
+
+		if v := criterion.Contains; v != nil {
+			tfMap["contains"] = aws.StringValueSlice(v)
+		}
+
+		if v := criterion.Eq; v != nil {
+			tfMap["eq"] = aws.StringValueSlice(v)
+		}
+
+		if v := criterion.Exists; v != nil {
+			tfMap["exists"] = strconv.FormatBool(aws.BoolValue(v))
+		}
+
+		if v := criterion.Neq; v != nil {
+			tfMap["neq"] = aws.StringValueSlice(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,226 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccArchiveRule_basic(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttrPair(resourceName, "analyzer_name", "aws_accessanalyzer_analyzer.test", "analyzer_name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "isPublic",
+						"eq.#":     "1",
+						"eq.0":     "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "error",
+						"exists":   "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccArchiveRule_disappears(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName, &archiveRule),
+					acctest.CheckResourceDisappears(acctest.Provider, tfaccessanalyzer.ResourceArchiveRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccArchiveRule_update(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+				),
+			},
+			{
+				Config: testAccArchiveRuleConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "resourceType",
+						"neq.#":    "1",
+						"neq.0":    "AWS::S3::Bucket",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria":   "principal.AWS",
+						"contains.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckArchiveRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_accessanalyzer_archive_rule" {
+			continue
+		}
+
+		analyzerName, ruleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfaccessanalyzer.FindArchiveRule(conn, analyzerName, ruleName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Access Analyzer Archive Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckArchiveRuleExists(n string, v *accessanalyzer.ArchiveRuleSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Access Analyzer Archive Rule ID is set")
+		}
+
+		analyzerName, ruleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
+
+		output, err := tfaccessanalyzer.FindArchiveRule(conn, analyzerName, ruleName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccArchiveRuleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["false"]
+  }
+
+  filter {
+    criteria = "error"
+    exists   = true
+  }
+}
+`, rName)
+}
+
+func testAccArchiveRuleConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "resourceType"
+    neq      = ["AWS::S3::Bucket"]
+  }
+
+  filter {
+    criteria = "principal.AWS"
+    contains = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName)
+}
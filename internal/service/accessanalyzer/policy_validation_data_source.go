@@ -0,0 +1,269 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"index": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"key": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"span": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end":   dataSourcePolicyValidationPositionSchema(),
+												"start": dataSourcePolicyValidationPositionSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Locale_Values(), false),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.PolicyType_Values(), false),
+			},
+			"security_warning_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"suggestion_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"validate_policy_resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.ValidatePolicyResourceType_Values(), false),
+			},
+			"warning_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationPositionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"line": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"offset": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(d.Get("policy_document").(string)),
+		PolicyType:     aws.String(d.Get("policy_type").(string)),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = aws.String(v.(string))
+	}
+
+	var findings []*accessanalyzer.ValidatePolicyFinding
+
+	err := conn.ValidatePolicyPages(input, func(page *accessanalyzer.ValidatePolicyOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		findings = append(findings, page.Findings...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error validating Access Analyzer policy: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(input.String())))
+
+	counts := make(map[string]int)
+	for _, finding := range findings {
+		counts[aws.StringValue(finding.FindingType)]++
+	}
+
+	d.Set("error_count", counts[accessanalyzer.ValidatePolicyFindingTypeError])
+	d.Set("security_warning_count", counts[accessanalyzer.ValidatePolicyFindingTypeSecurityWarning])
+	d.Set("suggestion_count", counts[accessanalyzer.ValidatePolicyFindingTypeSuggestion])
+	d.Set("warning_count", counts[accessanalyzer.ValidatePolicyFindingTypeWarning])
+
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+
+	return nil
+}
+
+func flattenValidatePolicyFindings(apiObjects []*accessanalyzer.ValidatePolicyFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.StringValue(apiObject.FindingDetails),
+			"finding_type":    aws.StringValue(apiObject.FindingType),
+			"issue_code":      aws.StringValue(apiObject.IssueCode),
+			"learn_more_link": aws.StringValue(apiObject.LearnMoreLink),
+			"locations":       flattenValidatePolicyLocations(apiObject.Locations),
+		})
+	}
+
+	return tfList
+}
+
+func flattenValidatePolicyLocations(apiObjects []*accessanalyzer.Location) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"path": flattenValidatePolicyPathElements(apiObject.Path),
+		}
+
+		if v := apiObject.Span; v != nil {
+			tfMap["span"] = []interface{}{map[string]interface{}{
+				"end":   flattenValidatePolicyPosition(v.End),
+				"start": flattenValidatePolicyPosition(v.Start),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenValidatePolicyPathElements(apiObjects []*accessanalyzer.PathElement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"index": int(aws.Int64Value(apiObject.Index)),
+			"key":   aws.StringValue(apiObject.Key),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenValidatePolicyPosition(apiObject *accessanalyzer.Position) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"column": int(aws.Int64Value(apiObject.Column)),
+		"line":   int(aws.Int64Value(apiObject.Line)),
+		"offset": int(aws.Int64Value(apiObject.Offset)),
+	}}
+}
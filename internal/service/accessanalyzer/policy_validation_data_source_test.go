@@ -0,0 +1,85 @@
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "error_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warning_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "suggestion_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "warning_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_findings(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_findings,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "error_count", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "findings.*", map[string]string{
+						"finding_type": accessanalyzer.ValidatePolicyFindingTypeError,
+						"issue_code":   "INVALID_ACTION",
+					}),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.learn_more_link"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.locations.0.span.0.start.0.line"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig = `
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`
+
+const testAccPolicyValidationDataSourceConfig_findings = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:NotARealAction"]
+    resources = ["*"]
+  }
+}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`
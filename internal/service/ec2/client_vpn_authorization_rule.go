@@ -3,11 +3,13 @@ package ec2
 import (
 	"fmt"
 	"log"
+	"net"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,9 +21,14 @@ func ResourceClientVPNAuthorizationRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClientVPNAuthorizationRuleCreate,
 		Read:   resourceClientVPNAuthorizationRuleRead,
+		Update: schema.Noop,
 		Delete: resourceClientVPNAuthorizationRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("terminate_connections_on_destroy", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -59,6 +66,11 @@ func ResourceClientVPNAuthorizationRule() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidCIDRNetworkAddress,
 			},
+			"terminate_connections_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -169,9 +181,138 @@ func resourceClientVPNAuthorizationRuleDelete(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error waiting for EC2 Client VPN Authorization Rule (%s) delete: %w", d.Id(), err)
 	}
 
+	if d.Get("terminate_connections_on_destroy").(bool) {
+		if err := terminateClientVPNConnections(conn, endpointID, targetNetworkCIDR, d.Get("authorize_all_groups").(bool)); err != nil {
+			return fmt.Errorf("error terminating EC2 Client VPN Authorization Rule (%s) connections: %w", d.Id(), err)
+		}
+	}
+
 	return nil
 }
 
+// terminateClientVPNConnections terminates the active connections to the specified Client VPN endpoint
+// after the authorization rule for the specified target network is revoked.
+// Client VPN connections do not report their access groups, so terminating connections disconnects
+// every active client. Connections are therefore only terminated when the revoked rule authorized all
+// groups, or when it was the last rule covering the target network.
+func terminateClientVPNConnections(conn *ec2.EC2, endpointID, targetNetworkCIDR string, authorizeAllGroups bool) error {
+	covered, err := clientVPNAuthorizationRulesCover(conn, endpointID, targetNetworkCIDR, authorizeAllGroups)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if covered {
+		log.Printf("[DEBUG] EC2 Client VPN Endpoint (%s) rules still authorize access to %s, not terminating connections", endpointID, targetNetworkCIDR)
+		return nil
+	}
+
+	connections, err := FindClientVPNConnections(conn, &ec2.DescribeClientVpnConnectionsInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, v := range activeClientVPNConnections(connections) {
+		connectionID := aws.StringValue(v.ConnectionId)
+		input := &ec2.TerminateClientVpnConnectionsInput{
+			ClientVpnEndpointId: aws.String(endpointID),
+			ConnectionId:        aws.String(connectionID),
+		}
+
+		log.Printf("[DEBUG] Terminating EC2 Client VPN Connection: %s", input)
+		_, err := conn.TerminateClientVpnConnections(input)
+
+		if tfawserr.ErrCodeEquals(err, ErrCodeInvalidClientVpnEndpointIdNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error terminating EC2 Client VPN Connection (%s): %w", connectionID, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// clientVPNAuthorizationRulesCover returns whether an active authorization rule of the specified
+// Client VPN endpoint grants access to the whole target network. If allGroups is true, only rules
+// authorizing all groups are considered.
+func clientVPNAuthorizationRulesCover(conn *ec2.EC2, endpointID, targetNetworkCIDR string, allGroups bool) (bool, error) {
+	_, target, err := net.ParseCIDR(targetNetworkCIDR)
+
+	if err != nil {
+		return false, err
+	}
+
+	rules, err := FindClientVPNAuthorizationRules(conn, &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, rule := range rules {
+		if rule.Status == nil || aws.StringValue(rule.Status.Code) != ec2.ClientVpnAuthorizationRuleStatusCodeActive {
+			continue
+		}
+
+		if allGroups && !aws.BoolValue(rule.AccessAll) {
+			continue
+		}
+
+		_, destination, err := net.ParseCIDR(aws.StringValue(rule.DestinationCidr))
+
+		if err != nil {
+			continue
+		}
+
+		if cidrContains(destination, target) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// cidrContains returns whether network a contains network b.
+func cidrContains(a, b *net.IPNet) bool {
+	aOnes, aBits := a.Mask.Size()
+	bOnes, bBits := b.Mask.Size()
+
+	return aBits == bBits && aOnes <= bOnes && a.Contains(b.IP)
+}
+
+// activeClientVPNConnections returns the active connections from the specified
+// connections. DescribeClientVpnConnections also returns connections terminated
+// within the last 60 minutes.
+func activeClientVPNConnections(apiObjects []*ec2.ClientVpnConnection) []*ec2.ClientVpnConnection {
+	var active []*ec2.ClientVpnConnection
+
+	for _, apiObject := range apiObjects {
+		if apiObject.Status == nil || aws.StringValue(apiObject.Status.Code) != ec2.ClientVpnConnectionStatusCodeActive {
+			continue
+		}
+
+		active = append(active, apiObject)
+	}
+
+	return active
+}
+
 const clientVPNAuthorizationRuleIDSeparator = ","
 
 func ClientVPNAuthorizationRuleCreateResourceID(endpointID, targetNetworkCIDR, accessGroupID string) string {
@@ -221,6 +221,48 @@ func testAccClientVPNAuthorizationRule_subnets(t *testing.T) {
 	})
 }
 
+func testAccClientVPNAuthorizationRule_terminateConnectionsOnDestroy(t *testing.T) {
+	var v ec2.AuthorizationRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClientVPNAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigTerminateConnectionsOnDestroy(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNAuthorizationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "terminate_connections_on_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_connections_on_destroy"},
+			},
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigTerminateConnectionsOnDestroy(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNAuthorizationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "terminate_connections_on_destroy", "false"),
+				),
+			},
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigTerminateConnectionsOnDestroy(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientVPNAuthorizationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "terminate_connections_on_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClientVPNAuthorizationRuleDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -319,6 +361,17 @@ resource "aws_ec2_client_vpn_authorization_rule" "test" {
 `)
 }
 
+func testAccEc2ClientVpnAuthorizationRuleConfigTerminateConnectionsOnDestroy(rName string, terminateConnectionsOnDestroy bool) string {
+	return acctest.ConfigCompose(testAccEc2ClientVpnAuthorizationRuleBaseConfig(rName, 1), fmt.Sprintf(`
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id           = aws_ec2_client_vpn_endpoint.test.id
+  target_network_cidr              = aws_subnet.test[0].cidr_block
+  authorize_all_groups             = true
+  terminate_connections_on_destroy = %[1]t
+}
+`, terminateConnectionsOnDestroy))
+}
+
 func testAccEc2ClientVpnAuthorizationRuleConfigGroups(rName string, groupNames map[string]string) string {
 	var b strings.Builder
 	for k, v := range groupNames {
@@ -0,0 +1,178 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceClientVPNConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClientVPNConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"common_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_established_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"egress_bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"egress_packets": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingress_bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingress_packets": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"posture_compliance_statuses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": DataSourceFiltersSchema(),
+		},
+	}
+}
+
+func dataSourceClientVPNConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	input := &ec2.DescribeClientVpnConnectionsInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+	}
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindClientVPNConnections(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Endpoint (%s) Connections: %w", endpointID, err)
+	}
+
+	d.SetId(endpointID)
+
+	if err := d.Set("connections", flattenClientVPNConnections(output)); err != nil {
+		return fmt.Errorf("error setting connections: %w", err)
+	}
+
+	return nil
+}
+
+func flattenClientVPNConnection(apiObject *ec2.ClientVpnConnection) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"posture_compliance_statuses": aws.StringValueSlice(apiObject.PostureComplianceStatuses),
+	}
+
+	if v := apiObject.ClientIp; v != nil {
+		tfMap["client_ip"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CommonName; v != nil {
+		tfMap["common_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ConnectionEstablishedTime; v != nil {
+		tfMap["connection_established_time"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ConnectionId; v != nil {
+		tfMap["connection_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EgressBytes; v != nil {
+		tfMap["egress_bytes"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EgressPackets; v != nil {
+		tfMap["egress_packets"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IngressBytes; v != nil {
+		tfMap["ingress_bytes"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IngressPackets; v != nil {
+		tfMap["ingress_packets"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v.Code)
+	}
+
+	if v := apiObject.Username; v != nil {
+		tfMap["username"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenClientVPNConnections(apiObjects []*ec2.ClientVpnConnection) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenClientVPNConnection(apiObject))
+	}
+
+	return tfList
+}
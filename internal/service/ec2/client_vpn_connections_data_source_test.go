@@ -0,0 +1,40 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccClientVPNConnectionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+	datasourceName := "data.aws_ec2_client_vpn_connections.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClientVPNEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnConnectionsDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "client_vpn_endpoint_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "connections.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEc2ClientVpnConnectionsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEc2ClientVpnEndpointConfig(rName), `
+data "aws_ec2_client_vpn_connections" "test" {
+  client_vpn_endpoint_id = aws_ec2_client_vpn_endpoint.test.id
+}
+`)
+}
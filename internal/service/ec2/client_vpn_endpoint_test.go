@@ -26,9 +26,10 @@ func init() {
 }
 
 // This is part of an experimental feature, do not use this as a starting point for tests
-//   "This place is not a place of honor... no highly esteemed deed is commemorated here... nothing valued is here.
-//   What is here was dangerous and repulsive to us. This message is a warning about danger."
-//   --  https://hyperallergic.com/312318/a-nuclear-warning-designed-to-last-10000-years/
+//
+//	"This place is not a place of honor... no highly esteemed deed is commemorated here... nothing valued is here.
+//	What is here was dangerous and repulsive to us. This message is a warning about danger."
+//	--  https://hyperallergic.com/312318/a-nuclear-warning-designed-to-last-10000-years/
 func TestAccEC2ClientVPNEndpoint_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Endpoint": {
@@ -48,13 +49,16 @@ func TestAccEC2ClientVPNEndpoint_serial(t *testing.T) {
 			"vpcNoSecurityGroups":          testAccClientVPNEndpoint_vpcNoSecurityGroups,
 			"vpcSecurityGroups":            testAccClientVPNEndpoint_vpcSecurityGroups,
 			"basicDataSource":              testAccClientVPNEndpointDataSource_basic,
+			"basicPluralDataSource":        testAccClientVPNEndpointsDataSource_basic,
+			"connectionsDataSource":        testAccClientVPNConnectionsDataSource_basic,
 		},
 		"AuthorizationRule": {
-			"basic":              testAccClientVPNAuthorizationRule_basic,
-			"groups":             testAccClientVPNAuthorizationRule_groups,
-			"subnets":            testAccClientVPNAuthorizationRule_subnets,
-			"disappears":         testAccClientVPNAuthorizationRule_disappears,
-			"disappearsEndpoint": testAccClientVPNAuthorizationRule_Disappears_endpoint,
+			"basic":                testAccClientVPNAuthorizationRule_basic,
+			"groups":               testAccClientVPNAuthorizationRule_groups,
+			"subnets":              testAccClientVPNAuthorizationRule_subnets,
+			"disappears":           testAccClientVPNAuthorizationRule_disappears,
+			"disappearsEndpoint":   testAccClientVPNAuthorizationRule_Disappears_endpoint,
+			"terminateConnections": testAccClientVPNAuthorizationRule_terminateConnectionsOnDestroy,
 		},
 		"NetworkAssociation": {
 			"basic":                    testAccClientVPNNetworkAssociation_basic,
@@ -0,0 +1,63 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccClientVPNEndpointsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+	datasource1Name := "data.aws_ec2_client_vpn_endpoints.by_filter"
+	datasource2Name := "data.aws_ec2_client_vpn_endpoints.by_tags"
+	datasource3Name := "data.aws_ec2_client_vpn_endpoints.empty"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClientVPNEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnEndpointsDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasource1Name, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(datasource2Name, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasource2Name, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(datasource3Name, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEc2ClientVpnEndpointsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEc2ClientVpnEndpointConfig(rName), `
+data "aws_ec2_client_vpn_endpoints" "by_tags" {
+  tags = {
+    Name = aws_ec2_client_vpn_endpoint.test.tags["Name"]
+  }
+}
+
+data "aws_ec2_client_vpn_endpoints" "by_filter" {
+  filter {
+    name   = "endpoint-id"
+    values = [aws_ec2_client_vpn_endpoint.test.id]
+  }
+}
+
+data "aws_ec2_client_vpn_endpoints" "empty" {
+  filter {
+    name   = "endpoint-id"
+    values = ["cvpn-endpoint-00000000000000000"]
+  }
+
+  depends_on = [aws_ec2_client_vpn_endpoint.test]
+}
+`)
+}
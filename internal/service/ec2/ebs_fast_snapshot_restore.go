@@ -0,0 +1,158 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEBSFastSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEBSFastSnapshotRestoreCreate,
+		ReadWithoutTimeout:   resourceEBSFastSnapshotRestoreRead,
+		DeleteWithoutTimeout: resourceEBSFastSnapshotRestoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEBSFastSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	availabilityZone := d.Get("availability_zone").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	id := EBSFastSnapshotRestoreCreateResourceID(availabilityZone, snapshotID)
+	input := &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	log.Printf("[DEBUG] Enabling EBS Fast Snapshot Restore: %s", input)
+	output, err := conn.EnableFastSnapshotRestoresWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = enableFastSnapshotRestoreStateErrorItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return diag.Errorf("error enabling EBS Fast Snapshot Restore (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitFastSnapshotRestoreEnabled(conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for EBS Fast Snapshot Restore (%s) enable: %s", d.Id(), err)
+	}
+
+	return resourceEBSFastSnapshotRestoreRead(ctx, d, meta)
+}
+
+func resourceEBSFastSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	availabilityZone, snapshotID, err := EBSFastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindFastSnapshotRestoreByTwoPartKey(conn, availabilityZone, snapshotID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Fast Snapshot Restore %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EBS Fast Snapshot Restore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("availability_zone", output.AvailabilityZone)
+	d.Set("snapshot_id", output.SnapshotId)
+	d.Set("state", output.State)
+
+	return nil
+}
+
+func resourceEBSFastSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	availabilityZone, snapshotID, err := EBSFastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Disabling EBS Fast Snapshot Restore: %s", d.Id())
+	output, err := conn.DisableFastSnapshotRestoresWithContext(ctx, &ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	})
+
+	if err == nil && output != nil {
+		err = disableFastSnapshotRestoreStateErrorItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return diag.Errorf("error disabling EBS Fast Snapshot Restore (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitFastSnapshotRestoreDeleted(conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for EBS Fast Snapshot Restore (%s) disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const ebsFastSnapshotRestoreIDSeparator = ","
+
+func EBSFastSnapshotRestoreCreateResourceID(availabilityZone, snapshotID string) string {
+	parts := []string{availabilityZone, snapshotID}
+	id := strings.Join(parts, ebsFastSnapshotRestoreIDSeparator)
+
+	return id
+}
+
+func EBSFastSnapshotRestoreParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ebsFastSnapshotRestoreIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AVAILABILITY-ZONE%[2]sSNAPSHOT-ID", id, ebsFastSnapshotRestoreIDSeparator)
+}
@@ -0,0 +1,141 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2EBSFastSnapshotRestore_basic(t *testing.T) {
+	resourceName := "aws_ebs_fast_snapshot_restore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEBSFastSnapshotRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSFastSnapshotRestoreConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSFastSnapshotRestoreExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", "aws_ebs_snapshot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.FastSnapshotRestoreStateCodeEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSFastSnapshotRestore_disappears(t *testing.T) {
+	resourceName := "aws_ebs_fast_snapshot_restore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEBSFastSnapshotRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSFastSnapshotRestoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSFastSnapshotRestoreExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceEBSFastSnapshotRestore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEBSFastSnapshotRestoreExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EBS Fast Snapshot Restore ID is set")
+		}
+
+		availabilityZone, snapshotID, err := tfec2.EBSFastSnapshotRestoreParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err = tfec2.FindFastSnapshotRestoreByTwoPartKey(conn, availabilityZone, snapshotID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEBSFastSnapshotRestoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_fast_snapshot_restore" {
+			continue
+		}
+
+		availabilityZone, snapshotID, err := tfec2.EBSFastSnapshotRestoreParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.FindFastSnapshotRestoreByTwoPartKey(conn, availabilityZone, snapshotID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EBS Fast Snapshot Restore %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEBSFastSnapshotRestoreConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_fast_snapshot_restore" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  snapshot_id       = aws_ebs_snapshot.test.id
+}
+`, rName))
+}
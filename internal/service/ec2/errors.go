@@ -35,9 +35,12 @@ const (
 	ErrCodeInvalidInstanceIDNotFound                      = "InvalidInstanceID.NotFound"
 	ErrCodeInvalidInternetGatewayIDNotFound               = "InvalidInternetGatewayID.NotFound"
 	ErrCodeInvalidKeyPairNotFound                         = "InvalidKeyPair.NotFound"
+	ErrCodeInvalidLaunchTemplateIdNotFound                = "InvalidLaunchTemplateId.NotFound"
+	ErrCodeInvalidLaunchTemplateIdVersionNotFound         = "InvalidLaunchTemplateId.VersionNotFound"
 	ErrCodeInvalidNetworkAclEntryNotFound                 = "InvalidNetworkAclEntry.NotFound"
 	ErrCodeInvalidNetworkAclIDNotFound                    = "InvalidNetworkAclID.NotFound"
 	ErrCodeInvalidNetworkInterfaceIDNotFound              = "InvalidNetworkInterfaceID.NotFound"
+	ErrCodeInvalidNetworkInsightsAnalysisIdNotFound       = "InvalidNetworkInsightsAnalysisId.NotFound"
 	ErrCodeInvalidNetworkInsightsPathIdNotFound           = "InvalidNetworkInsightsPathId.NotFound"
 	ErrCodeInvalidParameter                               = "InvalidParameter"
 	ErrCodeInvalidParameterException                      = "InvalidParameterException"
@@ -52,6 +55,7 @@ const (
 	ErrCodeInvalidRouteTableIDNotFound                    = "InvalidRouteTableID.NotFound"
 	ErrCodeInvalidRouteTableIdNotFound                    = "InvalidRouteTableId.NotFound"
 	ErrCodeInvalidSecurityGroupIDNotFound                 = "InvalidSecurityGroupID.NotFound"
+	ErrCodeInvalidSecurityGroupRuleIdNotFound             = "InvalidSecurityGroupRuleId.NotFound"
 	ErrCodeInvalidSnapshotInUse                           = "InvalidSnapshot.InUse"
 	ErrCodeInvalidSnapshotNotFound                        = "InvalidSnapshot.NotFound"
 	ErrCodeInvalidSpotDatafeedNotFound                    = "InvalidSpotDatafeed.NotFound"
@@ -74,6 +78,7 @@ const (
 	ErrCodeInvalidVpnGatewayAttachmentNotFound            = "InvalidVpnGatewayAttachment.NotFound"
 	ErrCodeInvalidVpnGatewayIDNotFound                    = "InvalidVpnGatewayID.NotFound"
 	ErrCodeNatGatewayNotFound                             = "NatGatewayNotFound"
+	ErrCodePrefixListVersionMismatch                      = "PrefixListVersionMismatch"
 	ErrCodeUnsupportedOperation                           = "UnsupportedOperation"
 )
 
@@ -102,3 +107,45 @@ func UnsuccessfulItemsError(apiObjects []*ec2.UnsuccessfulItem) error {
 
 	return errors.ErrorOrNil()
 }
+
+func enableFastSnapshotRestoreStateErrorItemsError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil || v.Error == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func disableFastSnapshotRestoreStateErrorItemsError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil || v.Error == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
@@ -38,7 +38,7 @@ func ResourceInstance() *schema.Resource {
 		Update: resourceInstanceUpdate,
 		Delete: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceInstanceImport,
 		},
 
 		SchemaVersion: 1,
@@ -600,6 +600,11 @@ func ResourceInstance() *schema.Resource {
 					return
 				},
 			},
+			"user_data_replace_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"volume_tags": tftags.TagsSchema(),
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -695,6 +700,29 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Id() == "" || !diff.Get("user_data_replace_on_change").(bool) {
+					return nil
+				}
+
+				if diff.HasChange("user_data") {
+					o, n := diff.GetChange("user_data")
+
+					if userDataChanged(o.(string), n.(string)) {
+						if err := diff.ForceNew("user_data"); err != nil {
+							return err
+						}
+					}
+				}
+
+				if diff.HasChange("user_data_base64") {
+					if err := diff.ForceNew("user_data_base64"); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -715,6 +743,12 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return strings.ToLower(v) != ec2.VolumeTypeGp3 && new == "0"
 }
 
+func resourceInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_data_replace_on_change", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -2914,6 +2948,32 @@ func userDataHashSum(user_data string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// userDataChanged reports whether the planned user_data differs from the value in state.
+// State holds the SHA1 sum of the user data while the plan may hold either the raw
+// configuration value or its already hashed representation.
+func userDataChanged(old, new string) bool {
+	// echo -n "" | shasum
+	const emptyHashSum = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+
+	if old == emptyHashSum {
+		old = ""
+	}
+
+	if new == emptyHashSum {
+		new = ""
+	}
+
+	if old == new {
+		return false
+	}
+
+	if new != "" && old == userDataHashSum(new) {
+		return false
+	}
+
+	return true
+}
+
 func getInstanceVolumeIDs(conn *ec2.EC2, instanceId string) ([]string, error) {
 	volumeIds := []string{}
 
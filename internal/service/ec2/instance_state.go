@@ -0,0 +1,176 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceStateCreate,
+		ReadWithoutTimeout:   resourceInstanceStateRead,
+		UpdateWithoutTimeout: resourceInstanceStateUpdate,
+		DeleteWithoutTimeout: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstanceStateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{ec2.InstanceStateNameRunning, ec2.InstanceStateNameStopped}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("instance_id").(string)
+	instance, err := FindInstanceByID(conn, instanceID)
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Instance (%s): %s", instanceID, err)
+	}
+
+	if err := updateInstanceState(conn, instanceID, aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceInstanceStateRead(ctx, d, meta)
+}
+
+func resourceInstanceStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance State %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Instance State (%s): %s", d.Id(), err)
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("state", instance.State.Name)
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("state") {
+		o, n := d.GetChange("state")
+
+		if err := updateInstanceState(conn, d.Id(), o.(string), n.(string), d.Get("force").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceInstanceStateRead(ctx, d, meta)
+}
+
+func resourceInstanceStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing the resource only stops Terraform from managing the instance's power state.
+	log.Printf("[DEBUG] Removing EC2 Instance State %s from state", d.Id())
+
+	return nil
+}
+
+func resourceInstanceStateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("force", false)
+	d.Set("instance_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateInstanceState stops or starts the specified instance so that it converges on the configured state.
+// Instances in a transitional state are first allowed to settle.
+func updateInstanceState(conn *ec2.EC2, id string, currentState string, configuredState string, force bool, timeout time.Duration) error {
+	switch currentState {
+	case ec2.InstanceStateNamePending:
+		if err := WaitForInstanceRunning(conn, id, timeout); err != nil {
+			return err
+		}
+
+		currentState = ec2.InstanceStateNameRunning
+	case ec2.InstanceStateNameStopping:
+		if err := WaitForInstanceStopping(conn, id, timeout); err != nil {
+			return err
+		}
+
+		currentState = ec2.InstanceStateNameStopped
+	}
+
+	if currentState == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case ec2.InstanceStateNameRunning:
+		log.Printf("[INFO] Starting EC2 Instance: %s", id)
+		_, err := conn.StartInstances(&ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+		}
+
+		if err := WaitForInstanceRunning(conn, id, timeout); err != nil {
+			return err
+		}
+	case ec2.InstanceStateNameStopped:
+		log.Printf("[INFO] Stopping EC2 Instance: %s", id)
+		_, err := conn.StopInstances(&ec2.StopInstancesInput{
+			Force:       aws.Bool(force),
+			InstanceIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+		}
+
+		if err := WaitForInstanceStopping(conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -784,8 +784,16 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("description", dltv.LaunchTemplateVersions[0].VersionDescription)
 
-	ltData := dltv.LaunchTemplateVersions[0].LaunchTemplateData
+	if err := setLaunchTemplateData(d, dltv.LaunchTemplateVersions[0].LaunchTemplateData); err != nil {
+		return err
+	}
+
+	return nil
+}
 
+// setLaunchTemplateData sets the launch template data attributes shared by
+// aws_launch_template and aws_ec2_launch_template_version.
+func setLaunchTemplateData(d *schema.ResourceData, ltData *ec2.ResponseLaunchTemplateData) error {
 	d.Set("disable_api_termination", ltData.DisableApiTermination)
 	d.Set("image_id", ltData.ImageId)
 	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
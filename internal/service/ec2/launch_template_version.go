@@ -0,0 +1,445 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceLaunchTemplateVersionRead,
+		UpdateWithoutTimeout: resourceLaunchTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceLaunchTemplateVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: launchTemplateVersionSchema(),
+
+		CustomizeDiff: resourceLaunchTemplateVersionCustomizeDiff,
+	}
+}
+
+// launchTemplateVersionSchema returns the aws_ec2_launch_template_version schema.
+// The launch template data arguments are shared with aws_launch_template. As launch
+// template versions are immutable, changing any of them creates a new version.
+func launchTemplateVersionSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"create_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_by": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"default_version": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"launch_template_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"retain_versions": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"retained_versions": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"set_default_version": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"version_number": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+
+	launchTemplateSchema := ResourceLaunchTemplate().Schema
+
+	for _, k := range updateKeys {
+		s[k] = launchTemplateSchema[k]
+	}
+
+	return s
+}
+
+func resourceLaunchTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+
+	version, err := createLaunchTemplateVersion(ctx, conn, d, launchTemplateID)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(LaunchTemplateVersionCreateResourceID(launchTemplateID, version))
+
+	if d.Get("set_default_version").(bool) {
+		if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLaunchTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	launchTemplateID, version, err := LaunchTemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindLaunchTemplateVersionByTwoPartKey(conn, launchTemplateID, version)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Launch Template Version %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	output := outputRaw.(*ec2.LaunchTemplateVersion)
+
+	if v := output.CreateTime; v != nil {
+		d.Set("create_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("created_by", output.CreatedBy)
+	d.Set("default_version", output.DefaultVersion)
+	d.Set("description", output.VersionDescription)
+	d.Set("launch_template_id", output.LaunchTemplateId)
+	d.Set("version_number", output.VersionNumber)
+
+	if err := setLaunchTemplateData(d, output.LaunchTemplateData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLaunchTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	launchTemplateID, version, err := LaunchTemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	retained := expandLaunchTemplateVersionNumbers(d.Get("retained_versions").([]interface{}))
+
+	if d.HasChanges(updateKeys...) {
+		newVersion, err := createLaunchTemplateVersion(ctx, conn, d, launchTemplateID)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The replaced version is retained, or deleted below if retain_versions is not set.
+		d.SetId(LaunchTemplateVersionCreateResourceID(launchTemplateID, newVersion))
+		retained = append(retained, version)
+		d.Set("retained_versions", retained)
+		version = newVersion
+
+		if d.Get("set_default_version").(bool) {
+			if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	} else if d.HasChange("set_default_version") && d.Get("set_default_version").(bool) {
+		if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	retained, err = pruneLaunchTemplateVersions(ctx, conn, launchTemplateID, retained, d.Get("retain_versions").(int)-1)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("retained_versions", retained)
+
+	return resourceLaunchTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceLaunchTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	launchTemplateID, version, err := LaunchTemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	retained := expandLaunchTemplateVersionNumbers(d.Get("retained_versions").([]interface{}))
+
+	if _, err := pruneLaunchTemplateVersions(ctx, conn, launchTemplateID, retained, 0); err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("error deleting EC2 Launch Template Version (%s) retained versions: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Launch Template Version: %s", d.Id())
+	err = deleteLaunchTemplateVersions(ctx, conn, launchTemplateID, []int64{version})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidLaunchTemplateIdNotFound, ErrCodeInvalidLaunchTemplateIdVersionNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceLaunchTemplateVersionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, k := range updateKeys {
+		if !d.HasChange(k) {
+			continue
+		}
+
+		// A new version is created.
+		for _, k := range []string{"create_time", "created_by", "default_version", "retained_versions", "version_number"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func createLaunchTemplateVersion(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, launchTemplateID string) (int64, error) {
+	launchTemplateData, err := buildLaunchTemplateData(d)
+
+	if err != nil {
+		return 0, err
+	}
+
+	input := &ec2.CreateLaunchTemplateVersionInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateData: launchTemplateData,
+		LaunchTemplateId:   aws.String(launchTemplateID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Launch Template Version: %s", input)
+	output, err := conn.CreateLaunchTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return 0, fmt.Errorf("error creating EC2 Launch Template (%s) Version: %w", launchTemplateID, err)
+	}
+
+	version := aws.Int64Value(output.LaunchTemplateVersion.VersionNumber)
+
+	if v := output.Warning; v != nil && len(v.Errors) > 0 {
+		for _, v := range v.Errors {
+			log.Printf("[WARN] EC2 Launch Template (%s) Version (%d): %s: %s", launchTemplateID, version, aws.StringValue(v.Code), aws.StringValue(v.Message))
+		}
+	}
+
+	return version, nil
+}
+
+func setLaunchTemplateDefaultVersion(ctx context.Context, conn *ec2.EC2, launchTemplateID string, version int64) error {
+	input := &ec2.ModifyLaunchTemplateInput{
+		DefaultVersion:   aws.String(strconv.FormatInt(version, 10)),
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Launch Template: %s", input)
+	if _, err := conn.ModifyLaunchTemplateWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error setting EC2 Launch Template (%s) default version (%d): %w", launchTemplateID, version, err)
+	}
+
+	return nil
+}
+
+// pruneLaunchTemplateVersions deletes all but the most recent keep of the specified versions,
+// previously retained by this resource, and returns the versions that are still retained.
+// Versions that no longer exist are dropped. The launch template's default version is
+// never deleted and remains retained.
+func pruneLaunchTemplateVersions(ctx context.Context, conn *ec2.EC2, launchTemplateID string, retained []int64, keep int) ([]int64, error) {
+	if keep < 0 {
+		keep = 0
+	}
+
+	if len(retained) <= keep {
+		return retained, nil
+	}
+
+	versions, err := FindLaunchTemplateVersions(conn, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+	})
+
+	if err != nil {
+		return retained, fmt.Errorf("error reading EC2 Launch Template (%s) Versions: %w", launchTemplateID, err)
+	}
+
+	// Map each existing version to whether it can be deleted.
+	deletable := make(map[int64]bool, len(versions))
+	for _, v := range versions {
+		deletable[aws.Int64Value(v.VersionNumber)] = !aws.BoolValue(v.DefaultVersion)
+	}
+
+	sorted := make([]int64, len(retained))
+	copy(sorted, retained)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] > sorted[j]
+	})
+
+	var toDelete []int64
+	kept := make([]int64, 0, keep)
+
+	for i, version := range sorted {
+		v, ok := deletable[version]
+
+		if !ok {
+			continue
+		}
+
+		if i < keep || !v {
+			kept = append(kept, version)
+			continue
+		}
+
+		toDelete = append(toDelete, version)
+	}
+
+	if len(toDelete) > 0 {
+		log.Printf("[DEBUG] Deleting EC2 Launch Template (%s) Versions: %v", launchTemplateID, toDelete)
+		if err := deleteLaunchTemplateVersions(ctx, conn, launchTemplateID, toDelete); err != nil {
+			return retained, fmt.Errorf("error deleting retained EC2 Launch Template (%s) Versions: %w", launchTemplateID, err)
+		}
+	}
+
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i] < kept[j]
+	})
+
+	return kept, nil
+}
+
+func expandLaunchTemplateVersionNumbers(tfList []interface{}) []int64 {
+	var apiObjects []int64
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, int64(v.(int)))
+	}
+
+	return apiObjects
+}
+
+// launchTemplateVersionsMaxDeleteBatchSize is the maximum number of versions
+// that can be deleted in a single DeleteLaunchTemplateVersions call.
+const launchTemplateVersionsMaxDeleteBatchSize = 200
+
+func deleteLaunchTemplateVersions(ctx context.Context, conn *ec2.EC2, launchTemplateID string, versions []int64) error {
+	var errors *multierror.Error
+
+	for len(versions) > 0 {
+		n := launchTemplateVersionsMaxDeleteBatchSize
+
+		if len(versions) < n {
+			n = len(versions)
+		}
+
+		var batch []string
+
+		for _, v := range versions[:n] {
+			batch = append(batch, strconv.FormatInt(v, 10))
+		}
+
+		versions = versions[n:]
+
+		output, err := conn.DeleteLaunchTemplateVersionsWithContext(ctx, &ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(launchTemplateID),
+			Versions:         aws.StringSlice(batch),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.UnsuccessfullyDeletedLaunchTemplateVersions {
+			if v == nil || v.ResponseError == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.ResponseError.Code), aws.StringValue(v.ResponseError.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("version %d: %w", aws.Int64Value(v.VersionNumber), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+const launchTemplateVersionIDSeparator = ","
+
+func LaunchTemplateVersionCreateResourceID(launchTemplateID string, version int64) string {
+	parts := []string{launchTemplateID, strconv.FormatInt(version, 10)}
+	id := strings.Join(parts, launchTemplateVersionIDSeparator)
+
+	return id
+}
+
+func LaunchTemplateVersionParseResourceID(id string) (string, int64, error) {
+	parts := strings.Split(id, launchTemplateVersionIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		version, err := strconv.ParseInt(parts[1], 10, 64)
+
+		if err == nil {
+			return parts[0], version, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected LAUNCH-TEMPLATE-ID%[2]sVERSION-NUMBER", id, launchTemplateVersionIDSeparator)
+}
@@ -0,0 +1,386 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2LaunchTemplateVersion_basic(t *testing.T) {
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_ec2_launch_template_version.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig(rName, "t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					acctest.CheckResourceAttrRFC3339(resourceName, "create_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.micro"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_id", launchTemplateResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_versions", "set_default_version"},
+			},
+			{
+				Config: testAccLaunchTemplateVersionConfig(rName, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "3"),
+					// The replaced version is deleted.
+					testAccCheckLaunchTemplateVersionCount(launchTemplateResourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_disappears(t *testing.T) {
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_ec2_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceLaunchTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_setDefaultVersion(t *testing.T) {
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_ec2_launch_template_version.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionSetDefaultVersionConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "set_default_version", "false"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionSetDefaultVersionConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "set_default_version", "true"),
+					testAccCheckLaunchTemplateVersionIsDefault(launchTemplateResourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_blockDeviceMappingsEBSVolumeSize(t *testing.T) {
+	var v1, v2 ec2.LaunchTemplateVersion
+	resourceName := "aws_ec2_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionBlockDeviceMappingsEBSVolumeSizeConfig(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "block_device_mappings.0.ebs.0.volume_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionBlockDeviceMappingsEBSVolumeSizeConfig(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v2),
+					testAccCheckLaunchTemplateVersionReplaced(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "block_device_mappings.0.ebs.0.volume_size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_retainVersions(t *testing.T) {
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_ec2_launch_template_version.test"
+	otherResourceName := "aws_ec2_launch_template_version.other"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionRetainVersionsConfig(rName, "t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retain_versions", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.#", "0"),
+					// The default version (1) and the versions of both resources.
+					testAccCheckLaunchTemplateVersionCount(launchTemplateResourceName, 3),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionRetainVersionsConfig(rName, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					// The replaced version is retained.
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.0", "3"),
+					testAccCheckLaunchTemplateVersionCount(launchTemplateResourceName, 4),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionRetainVersionsConfig(rName, "t3.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(resourceName, &v),
+					// Only the most recently replaced version is retained and the other resource's version is kept.
+					testAccCheckLaunchTemplateVersionExists(otherResourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.0", "4"),
+					testAccCheckLaunchTemplateVersionCount(launchTemplateResourceName, 4),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchTemplateVersionExists(n string, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Launch Template Version ID is set")
+		}
+
+		launchTemplateID, version, err := tfec2.LaunchTemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(conn, launchTemplateID, version)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionReplaced(before, after *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.Int64Value(before.VersionNumber) == aws.Int64Value(after.VersionNumber) {
+			return fmt.Errorf("EC2 Launch Template Version (%d) not replaced", aws.Int64Value(before.VersionNumber))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionIsDefault(n string, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindLaunchTemplateVersion(conn, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+			Versions:         aws.StringSlice([]string{"$Default"}),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.Int64Value(output.VersionNumber), aws.Int64Value(v.VersionNumber); got != want {
+			return fmt.Errorf("EC2 Launch Template (%s) default version = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindLaunchTemplateVersions(conn, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Launch Template (%s) has %d versions, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_launch_template_version" {
+			continue
+		}
+
+		launchTemplateID, version, err := tfec2.LaunchTemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.FindLaunchTemplateVersionByTwoPartKey(conn, launchTemplateID, version)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Launch Template Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLaunchTemplateVersionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  # Versions are managed by aws_ec2_launch_template_version.
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`, rName)
+}
+
+func testAccLaunchTemplateVersionConfig(rName, instanceType string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  description        = %[1]q
+  instance_type      = %[2]q
+}
+`, rName, instanceType))
+}
+
+func testAccLaunchTemplateVersionSetDefaultVersionConfig(rName string, setDefaultVersion bool) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_launch_template_version" "test" {
+  launch_template_id  = aws_launch_template.test.id
+  instance_type       = "t3.micro"
+  set_default_version = %[1]t
+}
+`, setDefaultVersion))
+}
+
+func testAccLaunchTemplateVersionBlockDeviceMappingsEBSVolumeSizeConfig(rName string, volumeSize int) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+
+    ebs {
+      volume_size = %[1]d
+    }
+  }
+}
+`, volumeSize))
+}
+
+func testAccLaunchTemplateVersionRetainVersionsConfig(rName, instanceType string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_launch_template_version" "other" {
+  launch_template_id = aws_launch_template.test.id
+  instance_type      = "t3.nano"
+}
+
+resource "aws_ec2_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  instance_type      = %[1]q
+  retain_versions    = 2
+
+  depends_on = [aws_ec2_launch_template_version.other]
+}
+`, instanceType))
+}
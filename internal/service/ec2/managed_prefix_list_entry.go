@@ -1,11 +1,14 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -15,11 +18,11 @@ import (
 func ResourceManagedPrefixListEntry() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceManagedPrefixListEntryCreate,
-		Read:   resourceManagedPrefixListEntryRead,
-		Delete: resourceManagedPrefixListEntryDelete,
+		CreateWithoutTimeout: resourceManagedPrefixListEntryCreate,
+		ReadWithoutTimeout:   resourceManagedPrefixListEntryRead,
+		DeleteWithoutTimeout: resourceManagedPrefixListEntryDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceManagedPrefixListEntryImport,
+			StateContext: resourceManagedPrefixListEntryImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,56 +47,38 @@ func ResourceManagedPrefixListEntry() *schema.Resource {
 	}
 }
 
-func resourceManagedPrefixListEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	cidr := d.Get("cidr").(string)
 	plID := d.Get("prefix_list_id").(string)
 	id := ManagedPrefixListEntryCreateID(plID, cidr)
 
-	pl, err := FindManagedPrefixListByID(conn, plID)
-
-	if err != nil {
-		return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %w", plID, err)
-	}
-
 	addPrefixListEntry := &ec2.AddPrefixListEntry{Cidr: aws.String(cidr)}
 
 	if v, ok := d.GetOk("description"); ok {
 		addPrefixListEntry.Description = aws.String(v.(string))
 	}
 
-	input := &ec2.ModifyManagedPrefixListInput{
-		AddEntries:     []*ec2.AddPrefixListEntry{addPrefixListEntry},
-		CurrentVersion: pl.Version,
-		PrefixListId:   aws.String(plID),
-	}
-
-	_, err = conn.ModifyManagedPrefixList(input)
-
-	if err != nil {
-		return fmt.Errorf("error creating EC2 Managed Prefix List Entry (%s): %w", id, err)
+	if err := modifyManagedPrefixListEntries(ctx, conn, plID, addPrefixListEntry, nil); err != nil {
+		return diag.Errorf("error creating EC2 Managed Prefix List Entry (%s): %s", id, err)
 	}
 
 	d.SetId(id)
 
-	if _, err := WaitManagedPrefixListModified(conn, plID); err != nil {
-		return fmt.Errorf("error waiting for EC2 Managed Prefix List Entry (%s) create: %w", d.Id(), err)
-	}
-
-	return resourceManagedPrefixListEntryRead(d, meta)
+	return resourceManagedPrefixListEntryRead(ctx, d, meta)
 }
 
-func resourceManagedPrefixListEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	plID, cidr, err := ManagedPrefixListEntryParseID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ManagedPrefixListEntryCreateTimeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, ManagedPrefixListEntryCreateTimeout, func() (interface{}, error) {
 		return FindManagedPrefixListEntryByIDAndCIDR(conn, plID, cidr)
 	}, d.IsNewResource())
 
@@ -104,7 +89,7 @@ func resourceManagedPrefixListEntryRead(d *schema.ResourceData, meta interface{}
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 Managed Prefix List Entry (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading EC2 Managed Prefix List Entry (%s): %s", d.Id(), err)
 	}
 
 	entry := outputRaw.(*ec2.PrefixListEntry)
@@ -115,51 +100,185 @@ func resourceManagedPrefixListEntryRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceManagedPrefixListEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	plID, cidr, err := ManagedPrefixListEntryParseID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	pl, err := FindManagedPrefixListByID(conn, plID)
+	removePrefixListEntry := &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)}
+
+	if err := modifyManagedPrefixListEntries(ctx, conn, plID, nil, removePrefixListEntry); err != nil {
+		return diag.Errorf("error deleting EC2 Managed Prefix List Entry (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceManagedPrefixListEntryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	plID, cidr, err := ManagedPrefixListEntryParseID(d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %w", plID, err)
+		return nil, err
 	}
 
-	input := &ec2.ModifyManagedPrefixListInput{
-		CurrentVersion: pl.Version,
-		PrefixListId:   aws.String(plID),
-		RemoveEntries:  []*ec2.RemovePrefixListEntry{{Cidr: aws.String(cidr)}},
+	d.Set("cidr", cidr)
+	d.Set("prefix_list_id", plID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// managedPrefixListEntryMaxBatchSize is the maximum number of entries that can be
+// added or removed in a single ModifyManagedPrefixList call.
+const managedPrefixListEntryMaxBatchSize = 100
+
+type managedPrefixListEntryRequest struct {
+	addEntry    *ec2.AddPrefixListEntry
+	removeEntry *ec2.RemovePrefixListEntry
+	result      chan error
+}
+
+// managedPrefixListEntryQueues holds the requests waiting to be applied to each
+// prefix list. A prefix list has a key while its worker goroutine is running.
+var managedPrefixListEntryQueues = struct {
+	sync.Mutex
+	m map[string][]*managedPrefixListEntryRequest
+}{
+	m: make(map[string][]*managedPrefixListEntryRequest),
+}
+
+// modifyManagedPrefixListEntries queues an entry addition or removal for the specified
+// prefix list and blocks until it has been applied.
+// Requests queued while a modification of the same prefix list is in progress are
+// applied together in the next modification, avoiding version conflicts.
+func modifyManagedPrefixListEntries(ctx context.Context, conn *ec2.EC2, plID string, addEntry *ec2.AddPrefixListEntry, removeEntry *ec2.RemovePrefixListEntry) error {
+	request := &managedPrefixListEntryRequest{
+		addEntry:    addEntry,
+		removeEntry: removeEntry,
+		result:      make(chan error, 1),
 	}
 
-	_, err = conn.ModifyManagedPrefixList(input)
+	managedPrefixListEntryQueues.Lock()
+	pending, running := managedPrefixListEntryQueues.m[plID]
+	managedPrefixListEntryQueues.m[plID] = append(pending, request)
+	if !running {
+		go applyManagedPrefixListEntryQueue(conn, plID)
+	}
+	managedPrefixListEntryQueues.Unlock()
 
-	if err != nil {
-		return fmt.Errorf("error deleting EC2 Managed Prefix List Entry (%s): %w", d.Id(), err)
+	select {
+	case err := <-request.result:
+		return err
+	case <-ctx.Done():
 	}
 
-	_, err = WaitManagedPrefixListModified(conn, plID)
+	managedPrefixListEntryQueues.Lock()
+	pending = managedPrefixListEntryQueues.m[plID]
+	for i, v := range pending {
+		if v == request {
+			managedPrefixListEntryQueues.m[plID] = append(pending[:i:i], pending[i+1:]...)
+			managedPrefixListEntryQueues.Unlock()
 
-	if err != nil {
-		return fmt.Errorf("error waiting for EC2 Managed Prefix List Entry (%s) delete: %w", d.Id(), err)
+			return ctx.Err()
+		}
 	}
+	managedPrefixListEntryQueues.Unlock()
 
-	return nil
+	// The request is already being applied. Wait for its outcome so that the
+	// caller's state matches the prefix list.
+	return <-request.result
 }
 
-func resourceManagedPrefixListEntryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	plID, cidr, err := ManagedPrefixListEntryParseID(d.Id())
+// applyManagedPrefixListEntryQueue applies queued requests for the specified prefix
+// list until the queue is empty.
+func applyManagedPrefixListEntryQueue(conn *ec2.EC2, plID string) {
+	for {
+		managedPrefixListEntryQueues.Lock()
+		requests := managedPrefixListEntryQueues.m[plID]
+		if len(requests) == 0 {
+			delete(managedPrefixListEntryQueues.m, plID)
+			managedPrefixListEntryQueues.Unlock()
+
+			return
+		}
+		managedPrefixListEntryQueues.m[plID] = nil
+		managedPrefixListEntryQueues.Unlock()
+
+		applyManagedPrefixListEntryRequests(conn, plID, requests)
+	}
+}
+
+// applyManagedPrefixListEntryRequests applies the requests in chunks and reports
+// each request's outcome. When a chunk fails, its requests are retried individually
+// so that one bad entry doesn't fail the others.
+func applyManagedPrefixListEntryRequests(conn *ec2.EC2, plID string, requests []*managedPrefixListEntryRequest) {
+	mk := "ec2_managed_prefix_list_" + plID
+	conns.GlobalMutexKV.Lock(mk)
+	defer conns.GlobalMutexKV.Unlock(mk)
+
+	for len(requests) > 0 {
+		n := managedPrefixListEntryMaxBatchSize
+		if len(requests) < n {
+			n = len(requests)
+		}
+
+		var chunk []*managedPrefixListEntryRequest
+		chunk, requests = requests[:n], requests[n:]
+
+		err := modifyManagedPrefixList(conn, plID, chunk)
+
+		if err != nil && len(chunk) > 1 {
+			for _, request := range chunk {
+				request.result <- modifyManagedPrefixList(conn, plID, []*managedPrefixListEntryRequest{request})
+			}
+
+			continue
+		}
+
+		for _, request := range chunk {
+			request.result <- err
+		}
+	}
+}
+
+func modifyManagedPrefixList(conn *ec2.EC2, plID string, requests []*managedPrefixListEntryRequest) error {
+	input := &ec2.ModifyManagedPrefixListInput{
+		PrefixListId: aws.String(plID),
+	}
+
+	for _, request := range requests {
+		if request.addEntry != nil {
+			input.AddEntries = append(input.AddEntries, request.addEntry)
+		}
+
+		if request.removeEntry != nil {
+			input.RemoveEntries = append(input.RemoveEntries, request.removeEntry)
+		}
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ManagedPrefixListEntryCreateTimeout, func() (interface{}, error) {
+		pl, err := FindManagedPrefixListByID(conn, plID)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading EC2 Managed Prefix List (%s): %w", plID, err)
+		}
+
+		input.CurrentVersion = pl.Version
+
+		log.Printf("[DEBUG] Modifying EC2 Managed Prefix List entries: %s", input)
+		return conn.ModifyManagedPrefixList(input)
+	}, ErrCodeIncorrectState, ErrCodePrefixListVersionMismatch)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	d.Set("cidr", cidr)
-	d.Set("prefix_list_id", plID)
+	if _, err := WaitManagedPrefixListModified(conn, plID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) modify: %w", plID, err)
+	}
 
-	return []*schema.ResourceData{d}, nil
+	return nil
 }
@@ -169,6 +169,56 @@ func TestAccEC2ManagedPrefixListEntry_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2ManagedPrefixListEntry_concurrent(t *testing.T) {
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckManagedPrefixListEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListEntryConcurrentConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntryCount(plResourceName, 20),
+				),
+			},
+			{
+				Config: testAccManagedPrefixListEntryConcurrentConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntryCount(plResourceName, 5),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ManagedPrefixListEntry_concurrentInvalid(t *testing.T) {
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckManagedPrefixListEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccManagedPrefixListEntryConcurrentInvalidConfig(rName, 5),
+				ExpectError: regexp.MustCompile(`error creating EC2 Managed Prefix List Entry`),
+			},
+			{
+				Config: testAccManagedPrefixListEntryConcurrentConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntryCount(plResourceName, 5),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckManagedPrefixListEntryDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -230,6 +280,29 @@ func testAccCheckManagedPrefixListEntryExists(n string, v *ec2.PrefixListEntry)
 	}
 }
 
+func testAccCheckManagedPrefixListEntryCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindManagedPrefixListEntriesByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Managed Prefix List (%s) has %d entries, expected %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccManagedPrefixListEntryImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -334,3 +407,33 @@ resource "aws_ec2_managed_prefix_list_entry" "test" {
 }
 `, rName)
 }
+
+func testAccManagedPrefixListEntryConcurrentConfig(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 25
+
+  lifecycle {
+    ignore_changes = [entry]
+  }
+}
+
+resource "aws_ec2_managed_prefix_list_entry" "test" {
+  count = %[2]d
+
+  cidr           = "10.0.${count.index}.0/24"
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+}
+`, rName, count)
+}
+
+func testAccManagedPrefixListEntryConcurrentInvalidConfig(rName string, count int) string {
+	return acctest.ConfigCompose(testAccManagedPrefixListEntryConcurrentConfig(rName, count), `
+resource "aws_ec2_managed_prefix_list_entry" "invalid" {
+  cidr           = "2001:db8::/56"
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+}
+`)
+}
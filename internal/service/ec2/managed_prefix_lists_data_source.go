@@ -0,0 +1,62 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceManagedPrefixLists() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagedPrefixListsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceManagedPrefixListsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeManagedPrefixListsInput{}
+
+	input.Filters = append(input.Filters, BuildTagFilterList(
+		Tags(tftags.New(d.Get("tags").(map[string]interface{}))),
+	)...)
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindManagedPrefixLists(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix Lists: %w", err)
+	}
+
+	var prefixListIDs []string
+
+	for _, v := range output {
+		prefixListIDs = append(prefixListIDs, aws.StringValue(v.PrefixListId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", prefixListIDs)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2ManagedPrefixListsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ManagedPrefixListsDataSource_filter(t *testing.T) {
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListsDataSourceConfig_filter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "data.aws_ec2_managed_prefix_list.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ManagedPrefixListsDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListsDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "aws_ec2_managed_prefix_list.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ManagedPrefixListsDataSource_noMatches(t *testing.T) {
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListsDataSourceConfig_noMatches,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccManagedPrefixListsDataSourceConfig_basic = `
+data "aws_ec2_managed_prefix_lists" "test" {}
+`
+
+const testAccManagedPrefixListsDataSourceConfig_filter = `
+data "aws_region" "current" {}
+
+data "aws_ec2_managed_prefix_lists" "test" {
+  filter {
+    name   = "prefix-list-name"
+    values = ["com.amazonaws.${data.aws_region.current.name}.dynamodb"]
+  }
+
+  filter {
+    name   = "owner-id"
+    values = ["AWS"]
+  }
+}
+
+data "aws_ec2_managed_prefix_list" "test" {
+  name = "com.amazonaws.${data.aws_region.current.name}.dynamodb"
+}
+`
+
+func testAccManagedPrefixListsDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_managed_prefix_lists" "test" {
+  tags = {
+    Name = aws_ec2_managed_prefix_list.test.tags["Name"]
+  }
+}
+`, rName)
+}
+
+const testAccManagedPrefixListsDataSourceConfig_noMatches = `
+data "aws_ec2_managed_prefix_lists" "test" {
+  filter {
+    name   = "prefix-list-name"
+    values = ["no-match"]
+  }
+}
+`
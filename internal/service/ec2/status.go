@@ -226,6 +226,22 @@ const (
 	RouteTableStatusReady = "ready"
 )
 
+func StatusNetworkInsightsAnalysis(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkInsightsAnalysisByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func StatusRouteTable(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindRouteTableByID(conn, id)
@@ -900,6 +916,22 @@ func statusVPCEndpointConnectionVPCEndpointState(conn *ec2.EC2, serviceID, vpcEn
 	}
 }
 
+func StatusFastSnapshotRestore(conn *ec2.EC2, availabilityZone, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFastSnapshotRestoreByTwoPartKey(conn, availabilityZone, snapshotID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusSnapshotTierStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindSnapshotTierStatusById(conn, id)
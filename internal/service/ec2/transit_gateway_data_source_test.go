@@ -47,6 +47,10 @@ func TestAccEC2TransitGatewayDataSource_serial(t *testing.T) {
 			"Tags":   testAccTransitGatewayRouteTablesDataSource_tags,
 			"Empty":  testAccTransitGatewayRouteTablesDataSource_empty,
 		},
+		"RouteTableRoutes": {
+			"basic":  testAccTransitGatewayRouteTableRoutesDataSource_basic,
+			"Filter": testAccTransitGatewayRouteTableRoutesDataSource_filter,
+		},
 		"VpcAttachment": {
 			"Filter": testAccTransitGatewayVPCAttachmentDataSource_Filter,
 			"ID":     testAccTransitGatewayVPCAttachmentDataSource_ID,
@@ -0,0 +1,152 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceTransitGatewayRouteTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayRouteTableRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix_list_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_attachments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_gateway_attachment_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayRouteTableRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTableID := d.Get("transit_gateway_route_table_id").(string)
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		MaxResults:                 aws.Int64(1000),
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	}
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	// SearchTransitGatewayRoutes requires at least one filter.
+	if len(input.Filters) == 0 {
+		input.Filters = []*ec2.Filter{
+			{
+				Name:   aws.String("type"),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayRouteTypeStatic, ec2.TransitGatewayRouteTypePropagated}),
+			},
+		}
+	}
+
+	output, err := FindTransitGatewayRoutes(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) Routes: %w", routeTableID, err)
+	}
+
+	d.SetId(routeTableID)
+
+	if err := d.Set("routes", flattenTransitGatewayRoutes(output)); err != nil {
+		return fmt.Errorf("error setting routes: %w", err)
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayRoutes(apiObjects []*ec2.TransitGatewayRoute) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"destination_cidr_block":      aws.StringValue(apiObject.DestinationCidrBlock),
+			"prefix_list_id":              aws.StringValue(apiObject.PrefixListId),
+			"state":                       aws.StringValue(apiObject.State),
+			"transit_gateway_attachments": flattenTransitGatewayRouteAttachments(apiObject.TransitGatewayAttachments),
+			"type":                        aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTransitGatewayRouteAttachments(apiObjects []*ec2.TransitGatewayRouteAttachment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"resource_id":                   aws.StringValue(apiObject.ResourceId),
+			"resource_type":                 aws.StringValue(apiObject.ResourceType),
+			"transit_gateway_attachment_id": aws.StringValue(apiObject.TransitGatewayAttachmentId),
+		})
+	}
+
+	return tfList
+}
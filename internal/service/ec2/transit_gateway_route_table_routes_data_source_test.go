@@ -0,0 +1,93 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayRouteTableRoutesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "routes.*", map[string]string{
+						"destination_cidr_block": "0.0.0.0/0",
+						"state":                  ec2.TransitGatewayRouteStateActive,
+						"type":                   ec2.TransitGatewayRouteTypeStatic,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "routes.*", map[string]string{
+						"destination_cidr_block": "10.0.0.0/16",
+						"type":                   ec2.TransitGatewayRouteTypePropagated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSource_filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesDataSourceFilterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.type", ec2.TransitGatewayRouteTypeStatic),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_type", ec2.TransitGatewayAttachmentResourceTypeVpc),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteDestinationCIDRBlockConfig(rName), `
+data "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+
+  depends_on = [aws_ec2_transit_gateway_route.test]
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSourceFilterConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteDestinationCIDRBlockConfig(rName), fmt.Sprintf(`
+data "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+
+  filter {
+    name   = "type"
+    values = [%[1]q]
+  }
+
+  filter {
+    name   = "route-search.exact-match"
+    values = ["0.0.0.0/0"]
+  }
+
+  depends_on = [aws_ec2_transit_gateway_route.test]
+}
+`, ec2.TransitGatewayRouteTypeStatic))
+}
@@ -0,0 +1,125 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceVPCIpamPoolAllocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPCIpamPoolAllocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"ipam_pool_allocation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ipam_pool_allocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipam_pool_allocation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCIpamPoolAllocationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	if v, ok := d.GetOk("ipam_pool_allocation_id"); ok {
+		input.IpamPoolAllocationId = aws.String(v.(string))
+	}
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindIpamPoolAllocations(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading IPAM Pool (%s) Allocations: %w", poolID, err)
+	}
+
+	d.SetId(poolID)
+
+	if err := d.Set("ipam_pool_allocations", flattenIpamPoolAllocations(output)); err != nil {
+		return fmt.Errorf("error setting ipam_pool_allocations: %w", err)
+	}
+
+	return nil
+}
+
+func flattenIpamPoolAllocations(apiObjects []*ec2.IpamPoolAllocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cidr":                    aws.StringValue(apiObject.Cidr),
+			"description":             aws.StringValue(apiObject.Description),
+			"ipam_pool_allocation_id": aws.StringValue(apiObject.IpamPoolAllocationId),
+			"resource_id":             aws.StringValue(apiObject.ResourceId),
+			"resource_owner":          aws.StringValue(apiObject.ResourceOwner),
+			"resource_region":         aws.StringValue(apiObject.ResourceRegion),
+			"resource_type":           aws.StringValue(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
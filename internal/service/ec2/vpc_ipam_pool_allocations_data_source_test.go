@@ -0,0 +1,69 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDataSourceVPCIpamPoolAllocations_basic(t *testing.T) {
+	dataSourceName := "data.aws_vpc_ipam_pool_allocations.test"
+	dataSourceNameByID := "data.aws_vpc_ipam_pool_allocations.by_id"
+	allocationResourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIpamPoolAllocationsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ipam_pool_allocations.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceNameByID, "ipam_pool_allocations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "ipam_pool_allocations.0.cidr", allocationResourceName, "cidr"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "ipam_pool_allocations.0.description", allocationResourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "ipam_pool_allocations.0.ipam_pool_allocation_id", allocationResourceName, "ipam_pool_allocation_id"),
+					resource.TestCheckResourceAttr(dataSourceNameByID, "ipam_pool_allocations.0.resource_type", ec2.IpamPoolAllocationResourceTypeCustom),
+				),
+			},
+		},
+	})
+}
+
+var testAccVPCIpamPoolAllocationsDataSourceConfig = acctest.ConfigCompose(testAccVPCIpamPoolCidrPrivateBase, `
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/28"
+  description  = "test"
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+
+resource "aws_vpc_ipam_pool_cidr_allocation" "test2" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.16/28"
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+
+data "aws_vpc_ipam_pool_allocations" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr_allocation.test,
+    aws_vpc_ipam_pool_cidr_allocation.test2,
+  ]
+}
+
+data "aws_vpc_ipam_pool_allocations" "by_id" {
+  ipam_pool_id            = aws_vpc_ipam_pool.test.id
+  ipam_pool_allocation_id = aws_vpc_ipam_pool_cidr_allocation.test.ipam_pool_allocation_id
+}
+`)
@@ -0,0 +1,107 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceVPCIpamPoolCidrs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPCIpamPoolCidrsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"ipam_pool_cidrs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCIpamPoolCidrsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.GetIpamPoolCidrsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindIpamPoolCidrs(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading IPAM Pool (%s) CIDRs: %w", poolID, err)
+	}
+
+	d.SetId(poolID)
+
+	if err := d.Set("ipam_pool_cidrs", flattenIpamPoolCidrs(output)); err != nil {
+		return fmt.Errorf("error setting ipam_pool_cidrs: %w", err)
+	}
+
+	return nil
+}
+
+func flattenIpamPoolCidrs(apiObjects []*ec2.IpamPoolCidr) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"cidr":  aws.StringValue(apiObject.Cidr),
+			"state": aws.StringValue(apiObject.State),
+		}
+
+		if v := apiObject.FailureReason; v != nil {
+			tfMap["failure_code"] = aws.StringValue(v.Code)
+			tfMap["failure_message"] = aws.StringValue(v.Message)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,61 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDataSourceVPCIpamPoolCidrs_basic(t *testing.T) {
+	dataSourceName := "data.aws_vpc_ipam_pool_cidrs.test"
+	dataSourceNameFiltered := "data.aws_vpc_ipam_pool_cidrs.filtered"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIpamPoolCidrsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ipam_pool_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceNameFiltered, "ipam_pool_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceNameFiltered, "ipam_pool_cidrs.0.cidr", "172.3.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceNameFiltered, "ipam_pool_cidrs.0.state", ec2.IpamPoolCidrStateProvisioned),
+				),
+			},
+		},
+	})
+}
+
+var testAccVPCIpamPoolCidrsDataSourceConfig = acctest.ConfigCompose(testAccVPCIpamPoolCidrPrivateBase, `
+resource "aws_vpc_ipam_pool_cidr" "test2" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.3.0.0/24"
+}
+
+data "aws_vpc_ipam_pool_cidrs" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test,
+    aws_vpc_ipam_pool_cidr.test2,
+  ]
+}
+
+data "aws_vpc_ipam_pool_cidrs" "filtered" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  filter {
+    name   = "cidr"
+    values = ["172.3.0.0/24"]
+  }
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test,
+    aws_vpc_ipam_pool_cidr.test2,
+  ]
+}
+`)
@@ -0,0 +1,42 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCSecurityGroupEgressRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCSecurityGroupEgressRuleCreate,
+		ReadWithoutTimeout:   resourceVPCSecurityGroupEgressRuleRead,
+		UpdateWithoutTimeout: resourceVPCSecurityGroupEgressRuleUpdate,
+		DeleteWithoutTimeout: resourceVPCSecurityGroupEgressRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: vpcSecurityGroupRuleSchema(),
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCSecurityGroupEgressRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleCreate(ctx, d, meta, true)
+}
+
+func resourceVPCSecurityGroupEgressRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleRead(ctx, d, meta, true)
+}
+
+func resourceVPCSecurityGroupEgressRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleUpdate(ctx, d, meta, true)
+}
+
+func resourceVPCSecurityGroupEgressRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleDelete(ctx, d, meta, true)
+}
@@ -0,0 +1,390 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCSecurityGroupIngressRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCSecurityGroupIngressRuleCreate,
+		ReadWithoutTimeout:   resourceVPCSecurityGroupIngressRuleRead,
+		UpdateWithoutTimeout: resourceVPCSecurityGroupIngressRuleUpdate,
+		DeleteWithoutTimeout: resourceVPCSecurityGroupIngressRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: vpcSecurityGroupRuleSchema(),
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCSecurityGroupIngressRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleCreate(ctx, d, meta, false)
+}
+
+func resourceVPCSecurityGroupIngressRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleRead(ctx, d, meta, false)
+}
+
+func resourceVPCSecurityGroupIngressRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleUpdate(ctx, d, meta, false)
+}
+
+func resourceVPCSecurityGroupIngressRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVPCSecurityGroupRuleDelete(ctx, d, meta, false)
+}
+
+// vpcSecurityGroupRuleSchema returns the schema shared by the aws_vpc_security_group_ingress_rule
+// and aws_vpc_security_group_egress_rule resources.
+func vpcSecurityGroupRuleSchema() map[string]*schema.Schema {
+	// Exactly one source or destination may be specified per rule.
+	targets := []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"}
+
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_ipv4": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			ExactlyOneOf: targets,
+		},
+		"cidr_ipv6": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			ExactlyOneOf: targets,
+		},
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 255),
+		},
+		"from_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+		"ip_protocol": {
+			Type:      schema.TypeString,
+			Required:  true,
+			StateFunc: ProtocolStateFunc,
+		},
+		"prefix_list_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: targets,
+		},
+		"referenced_security_group_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: targets,
+		},
+		"security_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"security_group_rule_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags":     tftags.TagsSchema(),
+		"tags_all": tftags.TagsSchemaComputed(),
+		"to_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+	}
+}
+
+func resourceVPCSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, isEgress bool) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ipPermission := expandIPPermissionForSecurityGroupRule(d)
+	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule)
+	ruleType := vpcSecurityGroupRuleTypeName(isEgress)
+
+	var rules []*ec2.SecurityGroupRule
+
+	if isEgress {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating VPC Security Group %s Rule: %s", ruleType, input)
+		output, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating VPC Security Group (%s) %s Rule: %s", securityGroupID, ruleType, err)
+		}
+
+		rules = output.SecurityGroupRules
+	} else {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating VPC Security Group %s Rule: %s", ruleType, input)
+		output, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating VPC Security Group (%s) %s Rule: %s", securityGroupID, ruleType, err)
+		}
+
+		rules = output.SecurityGroupRules
+	}
+
+	if len(rules) != 1 || rules[0] == nil {
+		return diag.Errorf("error creating VPC Security Group (%s) %s Rule: expected 1 rule, got %d", securityGroupID, ruleType, len(rules))
+	}
+
+	d.SetId(aws.StringValue(rules[0].SecurityGroupRuleId))
+
+	return resourceVPCSecurityGroupRuleRead(ctx, d, meta, isEgress)
+}
+
+func resourceVPCSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}, isEgress bool) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ruleType := vpcSecurityGroupRuleTypeName(isEgress)
+	rule, err := FindSecurityGroupRuleByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group %s Rule %s not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Security Group %s Rule (%s): %s", ruleType, d.Id(), err)
+	}
+
+	if aws.BoolValue(rule.IsEgress) != isEgress {
+		return diag.Errorf("VPC Security Group Rule (%s) is not an %s rule", d.Id(), ruleType)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	protocol := ProtocolForValue(aws.StringValue(rule.IpProtocol))
+	d.Set("ip_protocol", protocol)
+	// Ports are not meaningful when all protocols are allowed.
+	if protocol != "-1" {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	} else {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	}
+	d.Set("prefix_list_id", rule.PrefixListId)
+	if rule.ReferencedGroupInfo != nil {
+		d.Set("referenced_security_group_id", rule.ReferencedGroupInfo.GroupId)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVPCSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, isEgress bool) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	ruleType := vpcSecurityGroupRuleTypeName(isEgress)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating VPC Security Group %s Rule: %s", ruleType, input)
+		_, err := conn.ModifySecurityGroupRulesWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating VPC Security Group %s Rule (%s): %s", ruleType, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating VPC Security Group %s Rule (%s) tags: %s", ruleType, d.Id(), err)
+		}
+	}
+
+	return resourceVPCSecurityGroupRuleRead(ctx, d, meta, isEgress)
+}
+
+func resourceVPCSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, isEgress bool) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	ruleType := vpcSecurityGroupRuleTypeName(isEgress)
+	securityGroupID := d.Get("security_group_id").(string)
+	var err error
+
+	log.Printf("[DEBUG] Deleting VPC Security Group %s Rule: %s", ruleType, d.Id())
+	if isEgress {
+		_, err = conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+		})
+	} else {
+		_, err = conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound, ErrCodeInvalidSecurityGroupIDNotFound, ErrCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Security Group %s Rule (%s): %s", ruleType, d.Id(), err)
+	}
+
+	return nil
+}
+
+func vpcSecurityGroupRuleTypeName(isEgress bool) string {
+	if isEgress {
+		return "Egress"
+	}
+
+	return "Ingress"
+}
+
+func expandIPPermissionForSecurityGroupRule(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+			GroupId:     aws.String(v.(string)),
+		}}
+	}
+
+	return apiObject
+}
+
+func expandSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.ReferencedGroupId = aws.String(v.(string))
+	}
+
+	return apiObject
+}
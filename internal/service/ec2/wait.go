@@ -358,6 +358,27 @@ const (
 	RouteTableAssociationDeletedTimeout = 5 * time.Minute
 )
 
+func WaitNetworkInsightsAnalysisCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInsightsAnalysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.AnalysisStatusRunning},
+		Target:     []string{ec2.AnalysisStatusSucceeded},
+		Refresh:    StatusNetworkInsightsAnalysis(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitRouteTableReady(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.RouteTable, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{},
@@ -1840,6 +1861,46 @@ func waitVPCEndpointConnectionAccepted(conn *ec2.EC2, serviceID, vpcEndpointID s
 	return nil, err
 }
 
+func WaitFastSnapshotRestoreEnabled(conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: StatusFastSnapshotRestore(conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreDeleted(conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeDisabling, ec2.FastSnapshotRestoreStateCodeEnabled, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{},
+		Refresh: StatusFastSnapshotRestore(conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitEBSSnapshotTierArchive(conn *ec2.EC2, id string) (*ec2.SnapshotTierStatus, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"standard"},
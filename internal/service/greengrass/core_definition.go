@@ -0,0 +1,274 @@
+package greengrass
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCoreDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCoreDefinitionCreate,
+		ReadWithoutTimeout:   resourceCoreDefinitionRead,
+		UpdateWithoutTimeout: resourceCoreDefinitionUpdate,
+		DeleteWithoutTimeout: resourceCoreDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sync_shadow": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"thing_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"latest_definition_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCoreDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrass.CreateCoreDefinitionInput{}
+
+	if v, ok := d.GetOk("core"); ok && len(v.([]interface{})) > 0 {
+		input.InitialVersion = &greengrass.CoreDefinitionVersion{
+			Cores: expandCores(v.([]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass Core Definition: %s", input)
+	output, err := conn.CreateCoreDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass Core Definition: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceCoreDefinitionRead(ctx, d, meta)
+}
+
+func resourceCoreDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindCoreDefinitionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass Core Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass Core Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("latest_definition_version_arn", output.LatestVersionArn)
+	d.Set("name", output.Name)
+
+	if v := aws.StringValue(output.LatestVersion); v != "" {
+		version, err := FindCoreDefinitionVersionByTwoPartKey(conn, d.Id(), v)
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass Core Definition (%s) version (%s): %s", d.Id(), v, err)
+		}
+
+		if err := d.Set("core", flattenCores(version.Cores)); err != nil {
+			return diag.Errorf("error setting core: %s", err)
+		}
+	} else {
+		d.Set("core", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCoreDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	if d.HasChange("name") {
+		input := &greengrass.UpdateCoreDefinitionInput{
+			CoreDefinitionId: aws.String(d.Id()),
+			Name:             aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Greengrass Core Definition: %s", input)
+		_, err := conn.UpdateCoreDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Greengrass Core Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("core") {
+		input := &greengrass.CreateCoreDefinitionVersionInput{
+			CoreDefinitionId: aws.String(d.Id()),
+			Cores:            expandCores(d.Get("core").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Creating Greengrass Core Definition Version: %s", input)
+		_, err := conn.CreateCoreDefinitionVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Greengrass Core Definition (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass Core Definition (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCoreDefinitionRead(ctx, d, meta)
+}
+
+func resourceCoreDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	log.Printf("[DEBUG] Deleting Greengrass Core Definition: %s", d.Id())
+	_, err := conn.DeleteCoreDefinitionWithContext(ctx, &greengrass.DeleteCoreDefinitionInput{
+		CoreDefinitionId: aws.String(d.Id()),
+	})
+
+	if isNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass Core Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCores(tfList []interface{}) []*greengrass.Core {
+	var apiObjects []*greengrass.Core
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrass.Core{}
+
+		if v, ok := tfMap["certificate_arn"].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["sync_shadow"].(bool); ok {
+			apiObject.SyncShadow = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["thing_arn"].(string); ok && v != "" {
+			apiObject.ThingArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCores(apiObjects []*greengrass.Core) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_arn": aws.StringValue(apiObject.CertificateArn),
+			"id":              aws.StringValue(apiObject.Id),
+			"sync_shadow":     aws.BoolValue(apiObject.SyncShadow),
+			"thing_arn":       aws.StringValue(apiObject.ThingArn),
+		})
+	}
+
+	return tfList
+}
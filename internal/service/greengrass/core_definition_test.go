@@ -0,0 +1,257 @@
+package greengrass_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrass "github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassCoreDefinition_basic(t *testing.T) {
+	resourceName := "aws_greengrass_core_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCoreDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`/greengrass/definition/cores/.+`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "latest_definition_version_arn", "greengrass", regexp.MustCompile(`/greengrass/definition/cores/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "core.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "core.0.certificate_arn", "aws_iot_certificate.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "core.0.id", "core"),
+					resource.TestCheckResourceAttr(resourceName, "core.0.sync_shadow", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "core.0.thing_arn", "aws_iot_thing.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassCoreDefinition_disappears(t *testing.T) {
+	resourceName := "aws_greengrass_core_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCoreDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrass.ResourceCoreDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassCoreDefinition_tags(t *testing.T) {
+	resourceName := "aws_greengrass_core_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCoreDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCoreDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCoreDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassCoreDefinition_update(t *testing.T) {
+	resourceName := "aws_greengrass_core_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCoreDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "core.0.sync_shadow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccCoreDefinitionConfig_updated(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCoreDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "core.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core.0.sync_shadow", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCoreDefinitionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass Core Definition ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+		_, err := tfgreengrass.FindCoreDefinitionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCoreDefinitionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrass_core_definition" {
+			continue
+		}
+
+		_, err := tfgreengrass.FindCoreDefinitionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass Core Definition %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCoreDefinitionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_certificate" "test" {
+  active = true
+}
+`, rName)
+}
+
+func testAccCoreDefinitionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCoreDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrass_core_definition" "test" {
+  name = %[1]q
+
+  core {
+    certificate_arn = aws_iot_certificate.test.arn
+    id              = "core"
+    thing_arn       = aws_iot_thing.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccCoreDefinitionConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccCoreDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrass_core_definition" "test" {
+  name = %[1]q
+
+  core {
+    certificate_arn = aws_iot_certificate.test.arn
+    id              = "core"
+    sync_shadow     = true
+    thing_arn       = aws_iot_thing.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccCoreDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_core_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCoreDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_core_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,7 @@
+package greengrass
+
+// Error code constants missing from AWS Go SDK:
+// https://docs.aws.amazon.com/sdk-for-go/api/service/greengrass/#pkg-constants
+const (
+	errCodeIDNotFoundException = "IdNotFoundException"
+)
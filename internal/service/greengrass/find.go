@@ -0,0 +1,321 @@
+package greengrass
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func isNotFoundErr(err error) bool {
+	return tfawserr.ErrCodeEquals(err, errCodeIDNotFoundException) || tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound)
+}
+
+func FindGroupByID(conn *greengrass.Greengrass, id string) (*greengrass.GetGroupOutput, error) {
+	input := &greengrass.GetGroupInput{
+		GroupId: aws.String(id),
+	}
+
+	output, err := conn.GetGroup(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindGroupVersionByTwoPartKey(conn *greengrass.Greengrass, groupID, versionID string) (*greengrass.GroupVersion, error) {
+	input := &greengrass.GetGroupVersionInput{
+		GroupId:        aws.String(groupID),
+		GroupVersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetGroupVersion(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+func FindCoreDefinitionByID(conn *greengrass.Greengrass, id string) (*greengrass.GetCoreDefinitionOutput, error) {
+	input := &greengrass.GetCoreDefinitionInput{
+		CoreDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetCoreDefinition(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindCoreDefinitionVersionByTwoPartKey(conn *greengrass.Greengrass, definitionID, versionID string) (*greengrass.CoreDefinitionVersion, error) {
+	input := &greengrass.GetCoreDefinitionVersionInput{
+		CoreDefinitionId:        aws.String(definitionID),
+		CoreDefinitionVersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetCoreDefinitionVersion(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+func FindFunctionDefinitionByID(conn *greengrass.Greengrass, id string) (*greengrass.GetFunctionDefinitionOutput, error) {
+	input := &greengrass.GetFunctionDefinitionInput{
+		FunctionDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetFunctionDefinition(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindFunctionDefinitionVersionByTwoPartKey(conn *greengrass.Greengrass, definitionID, versionID string) (*greengrass.FunctionDefinitionVersion, error) {
+	input := &greengrass.GetFunctionDefinitionVersionInput{
+		FunctionDefinitionId:        aws.String(definitionID),
+		FunctionDefinitionVersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetFunctionDefinitionVersion(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+func FindLoggerDefinitionByID(conn *greengrass.Greengrass, id string) (*greengrass.GetLoggerDefinitionOutput, error) {
+	input := &greengrass.GetLoggerDefinitionInput{
+		LoggerDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetLoggerDefinition(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindLoggerDefinitionVersionByTwoPartKey(conn *greengrass.Greengrass, definitionID, versionID string) (*greengrass.LoggerDefinitionVersion, error) {
+	input := &greengrass.GetLoggerDefinitionVersionInput{
+		LoggerDefinitionId:        aws.String(definitionID),
+		LoggerDefinitionVersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetLoggerDefinitionVersion(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+func FindResourceDefinitionByID(conn *greengrass.Greengrass, id string) (*greengrass.GetResourceDefinitionOutput, error) {
+	input := &greengrass.GetResourceDefinitionInput{
+		ResourceDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetResourceDefinition(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindResourceDefinitionVersionByTwoPartKey(conn *greengrass.Greengrass, definitionID, versionID string) (*greengrass.ResourceDefinitionVersion, error) {
+	input := &greengrass.GetResourceDefinitionVersionInput{
+		ResourceDefinitionId:        aws.String(definitionID),
+		ResourceDefinitionVersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetResourceDefinitionVersion(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+func FindSubscriptionDefinitionByID(conn *greengrass.Greengrass, id string) (*greengrass.GetSubscriptionDefinitionOutput, error) {
+	input := &greengrass.GetSubscriptionDefinitionInput{
+		SubscriptionDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriptionDefinition(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSubscriptionDefinitionVersionByTwoPartKey(conn *greengrass.Greengrass, definitionID, versionID string) (*greengrass.SubscriptionDefinitionVersion, error) {
+	input := &greengrass.GetSubscriptionDefinitionVersionInput{
+		SubscriptionDefinitionId:        aws.String(definitionID),
+		SubscriptionDefinitionVersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetSubscriptionDefinitionVersion(input)
+
+	if isNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
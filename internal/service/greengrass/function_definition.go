@@ -0,0 +1,615 @@
+package greengrass
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFunctionDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFunctionDefinitionCreate,
+		ReadWithoutTimeout:   resourceFunctionDefinitionRead,
+		UpdateWithoutTimeout: resourceFunctionDefinitionUpdate,
+		DeleteWithoutTimeout: resourceFunctionDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     functionExecutionConfigResource(),
+						},
+					},
+				},
+			},
+			"function": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"function_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encoding_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(greengrass.EncodingType_Values(), false),
+									},
+									"environment": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_sysfs": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"execution": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem:     functionExecutionConfigResource(),
+												},
+												"resource_access_policy": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"permission": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(greengrass.Permission_Values(), false),
+															},
+															"resource_id": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"variables": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"exec_args": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"executable": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"memory_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"pinned": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"timeout": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"latest_definition_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFunctionDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrass.CreateFunctionDefinitionInput{}
+
+	if v := expandFunctionDefinitionVersion(d); v != nil {
+		input.InitialVersion = v
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass Function Definition: %s", input)
+	output, err := conn.CreateFunctionDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass Function Definition: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceFunctionDefinitionRead(ctx, d, meta)
+}
+
+func resourceFunctionDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindFunctionDefinitionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass Function Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass Function Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("latest_definition_version_arn", output.LatestVersionArn)
+	d.Set("name", output.Name)
+
+	if v := aws.StringValue(output.LatestVersion); v != "" {
+		version, err := FindFunctionDefinitionVersionByTwoPartKey(conn, d.Id(), v)
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass Function Definition (%s) version (%s): %s", d.Id(), v, err)
+		}
+
+		if err := d.Set("default_config", flattenFunctionDefaultConfig(version.DefaultConfig)); err != nil {
+			return diag.Errorf("error setting default_config: %s", err)
+		}
+
+		if err := d.Set("function", flattenFunctions(version.Functions)); err != nil {
+			return diag.Errorf("error setting function: %s", err)
+		}
+	} else {
+		d.Set("default_config", nil)
+		d.Set("function", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFunctionDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	if d.HasChange("name") {
+		input := &greengrass.UpdateFunctionDefinitionInput{
+			FunctionDefinitionId: aws.String(d.Id()),
+			Name:                 aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Greengrass Function Definition: %s", input)
+		_, err := conn.UpdateFunctionDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Greengrass Function Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("default_config", "function") {
+		input := &greengrass.CreateFunctionDefinitionVersionInput{
+			FunctionDefinitionId: aws.String(d.Id()),
+		}
+
+		if v := expandFunctionDefinitionVersion(d); v != nil {
+			input.DefaultConfig = v.DefaultConfig
+			input.Functions = v.Functions
+		}
+
+		log.Printf("[DEBUG] Creating Greengrass Function Definition Version: %s", input)
+		_, err := conn.CreateFunctionDefinitionVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Greengrass Function Definition (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass Function Definition (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFunctionDefinitionRead(ctx, d, meta)
+}
+
+func resourceFunctionDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	log.Printf("[DEBUG] Deleting Greengrass Function Definition: %s", d.Id())
+	_, err := conn.DeleteFunctionDefinitionWithContext(ctx, &greengrass.DeleteFunctionDefinitionInput{
+		FunctionDefinitionId: aws.String(d.Id()),
+	})
+
+	if isNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass Function Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func functionExecutionConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"isolation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(greengrass.FunctionIsolationMode_Values(), false),
+			},
+			"run_as": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gid": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"uid": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFunctionDefinitionVersion(d *schema.ResourceData) *greengrass.FunctionDefinitionVersion {
+	apiObject := &greengrass.FunctionDefinitionVersion{}
+	empty := true
+
+	if v, ok := d.GetOk("default_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.DefaultConfig = expandFunctionDefaultConfig(v.([]interface{})[0].(map[string]interface{}))
+		empty = false
+	}
+
+	if v, ok := d.GetOk("function"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.Functions = expandFunctions(v.(*schema.Set).List())
+		empty = false
+	}
+
+	if empty {
+		return nil
+	}
+
+	return apiObject
+}
+
+func expandFunctionDefaultConfig(tfMap map[string]interface{}) *greengrass.FunctionDefaultConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrass.FunctionDefaultConfig{}
+
+	if v, ok := tfMap["execution"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		execution := expandFunctionExecutionConfig(v[0].(map[string]interface{}))
+
+		apiObject.Execution = &greengrass.FunctionDefaultExecutionConfig{
+			IsolationMode: execution.IsolationMode,
+			RunAs:         execution.RunAs,
+		}
+	}
+
+	return apiObject
+}
+
+func expandFunctionExecutionConfig(tfMap map[string]interface{}) *greengrass.FunctionExecutionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrass.FunctionExecutionConfig{}
+
+	if v, ok := tfMap["isolation_mode"].(string); ok && v != "" {
+		apiObject.IsolationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["run_as"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		runAs := &greengrass.FunctionRunAsConfig{}
+
+		if v, ok := tfMap["gid"].(int); ok {
+			runAs.Gid = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["uid"].(int); ok {
+			runAs.Uid = aws.Int64(int64(v))
+		}
+
+		apiObject.RunAs = runAs
+	}
+
+	return apiObject
+}
+
+func expandFunctions(tfList []interface{}) []*greengrass.Function {
+	var apiObjects []*greengrass.Function
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrass.Function{}
+
+		if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+			apiObject.FunctionArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["function_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FunctionConfiguration = expandFunctionConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFunctionConfiguration(tfMap map[string]interface{}) *greengrass.FunctionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrass.FunctionConfiguration{}
+
+	if v, ok := tfMap["encoding_type"].(string); ok && v != "" {
+		apiObject.EncodingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["environment"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Environment = expandFunctionConfigurationEnvironment(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["exec_args"].(string); ok && v != "" {
+		apiObject.ExecArgs = aws.String(v)
+	}
+
+	if v, ok := tfMap["executable"].(string); ok && v != "" {
+		apiObject.Executable = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory_size"].(int); ok && v != 0 {
+		apiObject.MemorySize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pinned"].(bool); ok {
+		apiObject.Pinned = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandFunctionConfigurationEnvironment(tfMap map[string]interface{}) *greengrass.FunctionConfigurationEnvironment {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrass.FunctionConfigurationEnvironment{}
+
+	if v, ok := tfMap["access_sysfs"].(bool); ok {
+		apiObject.AccessSysfs = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["execution"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Execution = expandFunctionExecutionConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["resource_access_policy"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			policy := &greengrass.ResourceAccessPolicy{}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				policy.Permission = aws.String(v)
+			}
+
+			if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+				policy.ResourceId = aws.String(v)
+			}
+
+			apiObject.ResourceAccessPolicies = append(apiObject.ResourceAccessPolicies, policy)
+		}
+	}
+
+	if v, ok := tfMap["variables"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Variables = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func flattenFunctionDefaultConfig(apiObject *greengrass.FunctionDefaultConfig) []interface{} {
+	if apiObject == nil || apiObject.Execution == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"execution": flattenFunctionExecutionConfig(&greengrass.FunctionExecutionConfig{
+			IsolationMode: apiObject.Execution.IsolationMode,
+			RunAs:         apiObject.Execution.RunAs,
+		}),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFunctionExecutionConfig(apiObject *greengrass.FunctionExecutionConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"isolation_mode": aws.StringValue(apiObject.IsolationMode),
+	}
+
+	if v := apiObject.RunAs; v != nil {
+		tfMap["run_as"] = []interface{}{map[string]interface{}{
+			"gid": aws.Int64Value(v.Gid),
+			"uid": aws.Int64Value(v.Uid),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFunctions(apiObjects []*greengrass.Function) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"function_arn": aws.StringValue(apiObject.FunctionArn),
+			"id":           aws.StringValue(apiObject.Id),
+		}
+
+		if v := apiObject.FunctionConfiguration; v != nil {
+			tfMap["function_configuration"] = []interface{}{flattenFunctionConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFunctionConfiguration(apiObject *greengrass.FunctionConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"encoding_type": aws.StringValue(apiObject.EncodingType),
+		"exec_args":     aws.StringValue(apiObject.ExecArgs),
+		"executable":    aws.StringValue(apiObject.Executable),
+		"memory_size":   aws.Int64Value(apiObject.MemorySize),
+		"pinned":        aws.BoolValue(apiObject.Pinned),
+		"timeout":       aws.Int64Value(apiObject.Timeout),
+	}
+
+	if v := apiObject.Environment; v != nil {
+		environment := map[string]interface{}{
+			"access_sysfs": aws.BoolValue(v.AccessSysfs),
+			"execution":    flattenFunctionExecutionConfig(v.Execution),
+			"variables":    aws.StringValueMap(v.Variables),
+		}
+
+		var policies []interface{}
+
+		for _, policy := range v.ResourceAccessPolicies {
+			if policy == nil {
+				continue
+			}
+
+			policies = append(policies, map[string]interface{}{
+				"permission":  aws.StringValue(policy.Permission),
+				"resource_id": aws.StringValue(policy.ResourceId),
+			})
+		}
+
+		environment["resource_access_policy"] = policies
+
+		tfMap["environment"] = []interface{}{environment}
+	}
+
+	return tfMap
+}
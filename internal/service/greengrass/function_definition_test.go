@@ -0,0 +1,311 @@
+package greengrass_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrass "github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassFunctionDefinition_basic(t *testing.T) {
+	resourceName := "aws_greengrass_function_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFunctionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`/greengrass/definition/functions/.+`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "latest_definition_version_arn", "greengrass", regexp.MustCompile(`/greengrass/definition/functions/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "function.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "function.*", map[string]string{
+						"id":                                                   "function",
+						"function_configuration.#":                             "1",
+						"function_configuration.0.executable":                  "index.handler",
+						"function_configuration.0.memory_size":                 "16384",
+						"function_configuration.0.pinned":                      "true",
+						"function_configuration.0.timeout":                     "3",
+						"function_configuration.0.environment.#":               "1",
+						"function_configuration.0.environment.0.variables.%":   "1",
+						"function_configuration.0.environment.0.variables.KEY": "value",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "function.*.function_arn", "aws_lambda_alias.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassFunctionDefinition_disappears(t *testing.T) {
+	resourceName := "aws_greengrass_function_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFunctionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrass.ResourceFunctionDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassFunctionDefinition_tags(t *testing.T) {
+	resourceName := "aws_greengrass_function_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFunctionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFunctionDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassFunctionDefinition_update(t *testing.T) {
+	resourceName := "aws_greengrass_function_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFunctionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccFunctionDefinitionConfig_updated(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_config.0.execution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_config.0.execution.0.isolation_mode", "NoContainer"),
+					resource.TestCheckResourceAttr(resourceName, "default_config.0.execution.0.run_as.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_config.0.execution.0.run_as.0.gid", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "default_config.0.execution.0.run_as.0.uid", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "function.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionDefinitionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass Function Definition ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+		_, err := tfgreengrass.FindFunctionDefinitionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckFunctionDefinitionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrass_function_definition" {
+			continue
+		}
+
+		_, err := tfgreengrass.FindFunctionDefinitionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass Function Definition %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFunctionDefinitionBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs14.x"
+  publish       = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+`, rName))
+}
+
+func testAccFunctionDefinitionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrass_function_definition" "test" {
+  name = %[1]q
+
+  function {
+    function_arn = aws_lambda_alias.test.arn
+    id           = "function"
+
+    function_configuration {
+      executable  = "index.handler"
+      memory_size = 16384
+      pinned      = true
+      timeout     = 3
+
+      environment {
+        variables = {
+          KEY = "value"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFunctionDefinitionConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrass_function_definition" "test" {
+  name = %[1]q
+
+  default_config {
+    execution {
+      isolation_mode = "NoContainer"
+
+      run_as {
+        gid = 1000
+        uid = 1000
+      }
+    }
+  }
+
+  function {
+    function_arn = aws_lambda_alias.test.arn
+    id           = "function"
+
+    function_configuration {
+      executable = "index.handler"
+      pinned     = false
+      timeout    = 10
+
+      environment {
+        execution {
+          isolation_mode = "NoContainer"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFunctionDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_function_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFunctionDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_function_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,288 @@
+package greengrass
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var groupVersionArnKeys = []string{
+	"connector_definition_version_arn",
+	"core_definition_version_arn",
+	"device_definition_version_arn",
+	"function_definition_version_arn",
+	"logger_definition_version_arn",
+	"resource_definition_version_arn",
+	"subscription_definition_version_arn",
+}
+
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupCreate,
+		ReadWithoutTimeout:   resourceGroupRead,
+		UpdateWithoutTimeout: resourceGroupUpdate,
+		DeleteWithoutTimeout: resourceGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connector_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"core_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"device_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"function_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logger_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"subscription_definition_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &greengrass.CreateGroupInput{
+		InitialVersion: expandGroupVersion(d),
+		Name:           aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass Group: %s", input)
+	output, err := conn.CreateGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("latest_version", output.LatestVersion)
+	d.Set("latest_version_arn", output.LatestVersionArn)
+	d.Set("name", output.Name)
+
+	version := &greengrass.GroupVersion{}
+
+	if v := aws.StringValue(output.LatestVersion); v != "" {
+		version, err = FindGroupVersionByTwoPartKey(conn, d.Id(), v)
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass Group (%s) version (%s): %s", d.Id(), v, err)
+		}
+	}
+
+	d.Set("connector_definition_version_arn", version.ConnectorDefinitionVersionArn)
+	d.Set("core_definition_version_arn", version.CoreDefinitionVersionArn)
+	d.Set("device_definition_version_arn", version.DeviceDefinitionVersionArn)
+	d.Set("function_definition_version_arn", version.FunctionDefinitionVersionArn)
+	d.Set("logger_definition_version_arn", version.LoggerDefinitionVersionArn)
+	d.Set("resource_definition_version_arn", version.ResourceDefinitionVersionArn)
+	d.Set("subscription_definition_version_arn", version.SubscriptionDefinitionVersionArn)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	if d.HasChange("name") {
+		input := &greengrass.UpdateGroupInput{
+			GroupId: aws.String(d.Id()),
+			Name:    aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Greengrass Group: %s", input)
+		_, err := conn.UpdateGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Greengrass Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges(groupVersionArnKeys...) {
+		input := &greengrass.CreateGroupVersionInput{
+			GroupId: aws.String(d.Id()),
+		}
+
+		if v := expandGroupVersion(d); v != nil {
+			input.ConnectorDefinitionVersionArn = v.ConnectorDefinitionVersionArn
+			input.CoreDefinitionVersionArn = v.CoreDefinitionVersionArn
+			input.DeviceDefinitionVersionArn = v.DeviceDefinitionVersionArn
+			input.FunctionDefinitionVersionArn = v.FunctionDefinitionVersionArn
+			input.LoggerDefinitionVersionArn = v.LoggerDefinitionVersionArn
+			input.ResourceDefinitionVersionArn = v.ResourceDefinitionVersionArn
+			input.SubscriptionDefinitionVersionArn = v.SubscriptionDefinitionVersionArn
+		}
+
+		log.Printf("[DEBUG] Creating Greengrass Group Version: %s", input)
+		_, err := conn.CreateGroupVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Greengrass Group (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	log.Printf("[DEBUG] Deleting Greengrass Group: %s", d.Id())
+	_, err := conn.DeleteGroupWithContext(ctx, &greengrass.DeleteGroupInput{
+		GroupId: aws.String(d.Id()),
+	})
+
+	if isNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandGroupVersion returns the group version described by the configuration,
+// or nil if no definition versions are configured.
+func expandGroupVersion(d *schema.ResourceData) *greengrass.GroupVersion {
+	apiObject := &greengrass.GroupVersion{}
+	empty := true
+
+	for _, key := range groupVersionArnKeys {
+		v, ok := d.GetOk(key)
+
+		if !ok {
+			continue
+		}
+
+		empty = false
+		arn := aws.String(v.(string))
+
+		switch key {
+		case "connector_definition_version_arn":
+			apiObject.ConnectorDefinitionVersionArn = arn
+		case "core_definition_version_arn":
+			apiObject.CoreDefinitionVersionArn = arn
+		case "device_definition_version_arn":
+			apiObject.DeviceDefinitionVersionArn = arn
+		case "function_definition_version_arn":
+			apiObject.FunctionDefinitionVersionArn = arn
+		case "logger_definition_version_arn":
+			apiObject.LoggerDefinitionVersionArn = arn
+		case "resource_definition_version_arn":
+			apiObject.ResourceDefinitionVersionArn = arn
+		case "subscription_definition_version_arn":
+			apiObject.SubscriptionDefinitionVersionArn = arn
+		}
+	}
+
+	if empty {
+		return nil
+	}
+
+	return apiObject
+}
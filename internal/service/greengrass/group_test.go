@@ -0,0 +1,252 @@
+package greengrass_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrass "github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassGroup_basic(t *testing.T) {
+	resourceName := "aws_greengrass_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`/greengrass/groups/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "core_definition_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", ""),
+					resource.TestCheckResourceAttr(resourceName, "latest_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "logger_definition_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassGroup_disappears(t *testing.T) {
+	resourceName := "aws_greengrass_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrass.ResourceGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassGroup_tags(t *testing.T) {
+	resourceName := "aws_greengrass_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassGroup_versions(t *testing.T) {
+	resourceName := "aws_greengrass_group.test"
+	coreDefinitionResourceName := "aws_greengrass_core_definition.test"
+	loggerDefinitionResourceName := "aws_greengrass_logger_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_coreDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "core_definition_version_arn", coreDefinitionResourceName, "latest_definition_version_arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "latest_version_arn", "greengrass", regexp.MustCompile(`/greengrass/groups/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "logger_definition_version_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupConfig_coreAndLoggerDefinitions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "core_definition_version_arn", coreDefinitionResourceName, "latest_definition_version_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "logger_definition_version_arn", loggerDefinitionResourceName, "latest_definition_version_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+		_, err := tfgreengrass.FindGroupByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrass_group" {
+			continue
+		}
+
+		_, err := tfgreengrass.FindGroupByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccGroupConfig_coreDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccCoreDefinitionConfig_basic(rName), fmt.Sprintf(`
+resource "aws_greengrass_group" "test" {
+  name = %[1]q
+
+  core_definition_version_arn = aws_greengrass_core_definition.test.latest_definition_version_arn
+}
+`, rName))
+}
+
+func testAccGroupConfig_coreAndLoggerDefinitions(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCoreDefinitionConfig_basic(rName),
+		testAccLoggerDefinitionConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_greengrass_group" "test" {
+  name = %[1]q
+
+  core_definition_version_arn   = aws_greengrass_core_definition.test.latest_definition_version_arn
+  logger_definition_version_arn = aws_greengrass_logger_definition.test.latest_definition_version_arn
+}
+`, rName))
+}
@@ -0,0 +1,285 @@
+package greengrass
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLoggerDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLoggerDefinitionCreate,
+		ReadWithoutTimeout:   resourceLoggerDefinitionRead,
+		UpdateWithoutTimeout: resourceLoggerDefinitionUpdate,
+		DeleteWithoutTimeout: resourceLoggerDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_definition_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logger": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(greengrass.LoggerComponent_Values(), false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(greengrass.LoggerLevel_Values(), false),
+						},
+						"space": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(greengrass.LoggerType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLoggerDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrass.CreateLoggerDefinitionInput{}
+
+	if v, ok := d.GetOk("logger"); ok && v.(*schema.Set).Len() > 0 {
+		input.InitialVersion = &greengrass.LoggerDefinitionVersion{
+			Loggers: expandLoggers(v.(*schema.Set).List()),
+		}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass Logger Definition: %s", input)
+	output, err := conn.CreateLoggerDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass Logger Definition: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceLoggerDefinitionRead(ctx, d, meta)
+}
+
+func resourceLoggerDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindLoggerDefinitionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass Logger Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass Logger Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("latest_definition_version_arn", output.LatestVersionArn)
+	d.Set("name", output.Name)
+
+	if v := aws.StringValue(output.LatestVersion); v != "" {
+		version, err := FindLoggerDefinitionVersionByTwoPartKey(conn, d.Id(), v)
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass Logger Definition (%s) version (%s): %s", d.Id(), v, err)
+		}
+
+		if err := d.Set("logger", flattenLoggers(version.Loggers)); err != nil {
+			return diag.Errorf("error setting logger: %s", err)
+		}
+	} else {
+		d.Set("logger", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLoggerDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	if d.HasChange("name") {
+		input := &greengrass.UpdateLoggerDefinitionInput{
+			LoggerDefinitionId: aws.String(d.Id()),
+			Name:               aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Greengrass Logger Definition: %s", input)
+		_, err := conn.UpdateLoggerDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Greengrass Logger Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("logger") {
+		input := &greengrass.CreateLoggerDefinitionVersionInput{
+			LoggerDefinitionId: aws.String(d.Id()),
+			Loggers:            expandLoggers(d.Get("logger").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Creating Greengrass Logger Definition Version: %s", input)
+		_, err := conn.CreateLoggerDefinitionVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Greengrass Logger Definition (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass Logger Definition (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLoggerDefinitionRead(ctx, d, meta)
+}
+
+func resourceLoggerDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	log.Printf("[DEBUG] Deleting Greengrass Logger Definition: %s", d.Id())
+	_, err := conn.DeleteLoggerDefinitionWithContext(ctx, &greengrass.DeleteLoggerDefinitionInput{
+		LoggerDefinitionId: aws.String(d.Id()),
+	})
+
+	if isNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass Logger Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLoggers(tfList []interface{}) []*greengrass.Logger {
+	var apiObjects []*greengrass.Logger
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrass.Logger{}
+
+		if v, ok := tfMap["component"].(string); ok && v != "" {
+			apiObject.Component = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["level"].(string); ok && v != "" {
+			apiObject.Level = aws.String(v)
+		}
+
+		if v, ok := tfMap["space"].(int); ok && v != 0 {
+			apiObject.Space = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLoggers(apiObjects []*greengrass.Logger) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"component": aws.StringValue(apiObject.Component),
+			"id":        aws.StringValue(apiObject.Id),
+			"level":     aws.StringValue(apiObject.Level),
+			"space":     aws.Int64Value(apiObject.Space),
+			"type":      aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
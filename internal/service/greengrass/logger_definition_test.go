@@ -0,0 +1,262 @@
+package greengrass_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrass "github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassLoggerDefinition_basic(t *testing.T) {
+	resourceName := "aws_greengrass_logger_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLoggerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggerDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`/greengrass/definition/loggers/.+`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "latest_definition_version_arn", "greengrass", regexp.MustCompile(`/greengrass/definition/loggers/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "logger.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "logger.*", map[string]string{
+						"component": "GreengrassSystem",
+						"id":        "system",
+						"level":     "INFO",
+						"space":     "1024",
+						"type":      "FileSystem",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassLoggerDefinition_disappears(t *testing.T) {
+	resourceName := "aws_greengrass_logger_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLoggerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggerDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrass.ResourceLoggerDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassLoggerDefinition_tags(t *testing.T) {
+	resourceName := "aws_greengrass_logger_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLoggerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggerDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggerDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLoggerDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassLoggerDefinition_update(t *testing.T) {
+	resourceName := "aws_greengrass_logger_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLoggerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggerDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccLoggerDefinitionConfig_updated(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoggerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logger.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "logger.*", map[string]string{
+						"component": "Lambda",
+						"id":        "lambda",
+						"level":     "DEBUG",
+						"type":      "AWSCloudWatch",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLoggerDefinitionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass Logger Definition ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+		_, err := tfgreengrass.FindLoggerDefinitionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckLoggerDefinitionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrass_logger_definition" {
+			continue
+		}
+
+		_, err := tfgreengrass.FindLoggerDefinitionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass Logger Definition %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLoggerDefinitionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_logger_definition" "test" {
+  name = %[1]q
+
+  logger {
+    component = "GreengrassSystem"
+    id        = "system"
+    level     = "INFO"
+    space     = 1024
+    type      = "FileSystem"
+  }
+}
+`, rName)
+}
+
+func testAccLoggerDefinitionConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_logger_definition" "test" {
+  name = %[1]q
+
+  logger {
+    component = "GreengrassSystem"
+    id        = "system"
+    level     = "WARN"
+    type      = "AWSCloudWatch"
+  }
+
+  logger {
+    component = "Lambda"
+    id        = "lambda"
+    level     = "DEBUG"
+    type      = "AWSCloudWatch"
+  }
+}
+`, rName)
+}
+
+func testAccLoggerDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_logger_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccLoggerDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_logger_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
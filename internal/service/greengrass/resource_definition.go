@@ -0,0 +1,561 @@
+package greengrass
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceDefinitionCreate,
+		ReadWithoutTimeout:   resourceResourceDefinitionRead,
+		UpdateWithoutTimeout: resourceResourceDefinitionUpdate,
+		DeleteWithoutTimeout: resourceResourceDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_definition_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_data_container": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"local_device_resource_data": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"group_owner_setting": groupOwnerSettingSchema(),
+												"source_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"local_volume_resource_data": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"group_owner_setting": groupOwnerSettingSchema(),
+												"source_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"s3_machine_learning_model_resource_data": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"owner_setting": resourceDownloadOwnerSettingSchema(),
+												"s3_uri": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"sagemaker_machine_learning_model_resource_data": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"owner_setting": resourceDownloadOwnerSettingSchema(),
+												"sagemaker_job_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"secrets_manager_secret_resource_data": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"additional_staging_labels_to_download": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceResourceDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrass.CreateResourceDefinitionInput{}
+
+	if v, ok := d.GetOk("resource"); ok && v.(*schema.Set).Len() > 0 {
+		input.InitialVersion = &greengrass.ResourceDefinitionVersion{
+			Resources: expandResources(v.(*schema.Set).List()),
+		}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass Resource Definition: %s", input)
+	output, err := conn.CreateResourceDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass Resource Definition: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceResourceDefinitionRead(ctx, d, meta)
+}
+
+func resourceResourceDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindResourceDefinitionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass Resource Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass Resource Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("latest_definition_version_arn", output.LatestVersionArn)
+	d.Set("name", output.Name)
+
+	if v := aws.StringValue(output.LatestVersion); v != "" {
+		version, err := FindResourceDefinitionVersionByTwoPartKey(conn, d.Id(), v)
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass Resource Definition (%s) version (%s): %s", d.Id(), v, err)
+		}
+
+		if err := d.Set("resource", flattenResources(version.Resources)); err != nil {
+			return diag.Errorf("error setting resource: %s", err)
+		}
+	} else {
+		d.Set("resource", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceResourceDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	if d.HasChange("name") {
+		input := &greengrass.UpdateResourceDefinitionInput{
+			ResourceDefinitionId: aws.String(d.Id()),
+			Name:                 aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Greengrass Resource Definition: %s", input)
+		_, err := conn.UpdateResourceDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Greengrass Resource Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource") {
+		input := &greengrass.CreateResourceDefinitionVersionInput{
+			ResourceDefinitionId: aws.String(d.Id()),
+			Resources:            expandResources(d.Get("resource").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Creating Greengrass Resource Definition Version: %s", input)
+		_, err := conn.CreateResourceDefinitionVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Greengrass Resource Definition (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass Resource Definition (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceResourceDefinitionRead(ctx, d, meta)
+}
+
+func resourceResourceDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	log.Printf("[DEBUG] Deleting Greengrass Resource Definition: %s", d.Id())
+	_, err := conn.DeleteResourceDefinitionWithContext(ctx, &greengrass.DeleteResourceDefinitionInput{
+		ResourceDefinitionId: aws.String(d.Id()),
+	})
+
+	if isNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass Resource Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func groupOwnerSettingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_add_group_owner": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"group_owner": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceDownloadOwnerSettingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group_owner": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"group_permission": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(greengrass.Permission_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandResources(tfList []interface{}) []*greengrass.Resource {
+	var apiObjects []*greengrass.Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrass.Resource{}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["resource_data_container"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResourceDataContainer = expandResourceDataContainer(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResourceDataContainer(tfMap map[string]interface{}) *greengrass.ResourceDataContainer {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrass.ResourceDataContainer{}
+
+	if v, ok := tfMap["local_device_resource_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LocalDeviceResourceData = &greengrass.LocalDeviceResourceData{
+			GroupOwnerSetting: expandGroupOwnerSetting(tfMap["group_owner_setting"].([]interface{})),
+			SourcePath:        aws.String(tfMap["source_path"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["local_volume_resource_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LocalVolumeResourceData = &greengrass.LocalVolumeResourceData{
+			DestinationPath:   aws.String(tfMap["destination_path"].(string)),
+			GroupOwnerSetting: expandGroupOwnerSetting(tfMap["group_owner_setting"].([]interface{})),
+			SourcePath:        aws.String(tfMap["source_path"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_machine_learning_model_resource_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3MachineLearningModelResourceData = &greengrass.S3MachineLearningModelResourceData{
+			DestinationPath: aws.String(tfMap["destination_path"].(string)),
+			OwnerSetting:    expandResourceDownloadOwnerSetting(tfMap["owner_setting"].([]interface{})),
+			S3Uri:           aws.String(tfMap["s3_uri"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sagemaker_machine_learning_model_resource_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SageMakerMachineLearningModelResourceData = &greengrass.SageMakerMachineLearningModelResourceData{
+			DestinationPath: aws.String(tfMap["destination_path"].(string)),
+			OwnerSetting:    expandResourceDownloadOwnerSetting(tfMap["owner_setting"].([]interface{})),
+			SageMakerJobArn: aws.String(tfMap["sagemaker_job_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["secrets_manager_secret_resource_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SecretsManagerSecretResourceData = &greengrass.SecretsManagerSecretResourceData{
+			ARN: aws.String(tfMap["arn"].(string)),
+		}
+
+		if v, ok := tfMap["additional_staging_labels_to_download"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SecretsManagerSecretResourceData.AdditionalStagingLabelsToDownload = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandGroupOwnerSetting(tfList []interface{}) *greengrass.GroupOwnerSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &greengrass.GroupOwnerSetting{
+		AutoAddGroupOwner: aws.Bool(tfMap["auto_add_group_owner"].(bool)),
+	}
+
+	if v, ok := tfMap["group_owner"].(string); ok && v != "" {
+		apiObject.GroupOwner = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandResourceDownloadOwnerSetting(tfList []interface{}) *greengrass.ResourceDownloadOwnerSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &greengrass.ResourceDownloadOwnerSetting{
+		GroupOwner:      aws.String(tfMap["group_owner"].(string)),
+		GroupPermission: aws.String(tfMap["group_permission"].(string)),
+	}
+}
+
+func flattenResources(apiObjects []*greengrass.Resource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":   aws.StringValue(apiObject.Id),
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.ResourceDataContainer; v != nil {
+			tfMap["resource_data_container"] = []interface{}{flattenResourceDataContainer(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenResourceDataContainer(apiObject *greengrass.ResourceDataContainer) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LocalDeviceResourceData; v != nil {
+		tfMap["local_device_resource_data"] = []interface{}{map[string]interface{}{
+			"group_owner_setting": flattenGroupOwnerSetting(v.GroupOwnerSetting),
+			"source_path":         aws.StringValue(v.SourcePath),
+		}}
+	}
+
+	if v := apiObject.LocalVolumeResourceData; v != nil {
+		tfMap["local_volume_resource_data"] = []interface{}{map[string]interface{}{
+			"destination_path":    aws.StringValue(v.DestinationPath),
+			"group_owner_setting": flattenGroupOwnerSetting(v.GroupOwnerSetting),
+			"source_path":         aws.StringValue(v.SourcePath),
+		}}
+	}
+
+	if v := apiObject.S3MachineLearningModelResourceData; v != nil {
+		tfMap["s3_machine_learning_model_resource_data"] = []interface{}{map[string]interface{}{
+			"destination_path": aws.StringValue(v.DestinationPath),
+			"owner_setting":    flattenResourceDownloadOwnerSetting(v.OwnerSetting),
+			"s3_uri":           aws.StringValue(v.S3Uri),
+		}}
+	}
+
+	if v := apiObject.SageMakerMachineLearningModelResourceData; v != nil {
+		tfMap["sagemaker_machine_learning_model_resource_data"] = []interface{}{map[string]interface{}{
+			"destination_path":  aws.StringValue(v.DestinationPath),
+			"owner_setting":     flattenResourceDownloadOwnerSetting(v.OwnerSetting),
+			"sagemaker_job_arn": aws.StringValue(v.SageMakerJobArn),
+		}}
+	}
+
+	if v := apiObject.SecretsManagerSecretResourceData; v != nil {
+		tfMap["secrets_manager_secret_resource_data"] = []interface{}{map[string]interface{}{
+			"additional_staging_labels_to_download": aws.StringValueSlice(v.AdditionalStagingLabelsToDownload),
+			"arn":                                   aws.StringValue(v.ARN),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenGroupOwnerSetting(apiObject *greengrass.GroupOwnerSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"auto_add_group_owner": aws.BoolValue(apiObject.AutoAddGroupOwner),
+		"group_owner":          aws.StringValue(apiObject.GroupOwner),
+	}}
+}
+
+func flattenResourceDownloadOwnerSetting(apiObject *greengrass.ResourceDownloadOwnerSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"group_owner":      aws.StringValue(apiObject.GroupOwner),
+		"group_permission": aws.StringValue(apiObject.GroupPermission),
+	}}
+}
@@ -0,0 +1,286 @@
+package greengrass_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrass "github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassResourceDefinition_basic(t *testing.T) {
+	resourceName := "aws_greengrass_resource_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`/greengrass/definition/resources/.+`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "latest_definition_version_arn", "greengrass", regexp.MustCompile(`/greengrass/definition/resources/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource.*", map[string]string{
+						"id":                        "volume",
+						"name":                      "volume",
+						"resource_data_container.#": "1",
+						"resource_data_container.0.local_volume_resource_data.#":                                            "1",
+						"resource_data_container.0.local_volume_resource_data.0.destination_path":                           "/dest",
+						"resource_data_container.0.local_volume_resource_data.0.source_path":                                "/src",
+						"resource_data_container.0.local_volume_resource_data.0.group_owner_setting.#":                      "1",
+						"resource_data_container.0.local_volume_resource_data.0.group_owner_setting.0.auto_add_group_owner": "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassResourceDefinition_disappears(t *testing.T) {
+	resourceName := "aws_greengrass_resource_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrass.ResourceResourceDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassResourceDefinition_tags(t *testing.T) {
+	resourceName := "aws_greengrass_resource_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccResourceDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassResourceDefinition_update(t *testing.T) {
+	resourceName := "aws_greengrass_resource_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccResourceDefinitionConfig_updated(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource.*", map[string]string{
+						"id":   "device",
+						"name": "device",
+						"resource_data_container.0.local_device_resource_data.#":             "1",
+						"resource_data_container.0.local_device_resource_data.0.source_path": "/dev/tty0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceDefinitionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass Resource Definition ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+		_, err := tfgreengrass.FindResourceDefinitionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckResourceDefinitionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrass_resource_definition" {
+			continue
+		}
+
+		_, err := tfgreengrass.FindResourceDefinitionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass Resource Definition %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccResourceDefinitionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_resource_definition" "test" {
+  name = %[1]q
+
+  resource {
+    id   = "volume"
+    name = "volume"
+
+    resource_data_container {
+      local_volume_resource_data {
+        destination_path = "/dest"
+        source_path      = "/src"
+
+        group_owner_setting {
+          auto_add_group_owner = true
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResourceDefinitionConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_resource_definition" "test" {
+  name = %[1]q
+
+  resource {
+    id   = "volume"
+    name = "volume"
+
+    resource_data_container {
+      local_volume_resource_data {
+        destination_path = "/dest"
+        source_path      = "/src"
+
+        group_owner_setting {
+          auto_add_group_owner = true
+        }
+      }
+    }
+  }
+
+  resource {
+    id   = "device"
+    name = "device"
+
+    resource_data_container {
+      local_device_resource_data {
+        source_path = "/dev/tty0"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResourceDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_resource_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccResourceDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_resource_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,271 @@
+package greengrass
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSubscriptionDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriptionDefinitionCreate,
+		ReadWithoutTimeout:   resourceSubscriptionDefinitionRead,
+		UpdateWithoutTimeout: resourceSubscriptionDefinitionUpdate,
+		DeleteWithoutTimeout: resourceSubscriptionDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_definition_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscription": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSubscriptionDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrass.CreateSubscriptionDefinitionInput{}
+
+	if v, ok := d.GetOk("subscription"); ok && v.(*schema.Set).Len() > 0 {
+		input.InitialVersion = &greengrass.SubscriptionDefinitionVersion{
+			Subscriptions: expandSubscriptions(v.(*schema.Set).List()),
+		}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass Subscription Definition: %s", input)
+	output, err := conn.CreateSubscriptionDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass Subscription Definition: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceSubscriptionDefinitionRead(ctx, d, meta)
+}
+
+func resourceSubscriptionDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindSubscriptionDefinitionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass Subscription Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass Subscription Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("latest_definition_version_arn", output.LatestVersionArn)
+	d.Set("name", output.Name)
+
+	if v := aws.StringValue(output.LatestVersion); v != "" {
+		version, err := FindSubscriptionDefinitionVersionByTwoPartKey(conn, d.Id(), v)
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass Subscription Definition (%s) version (%s): %s", d.Id(), v, err)
+		}
+
+		if err := d.Set("subscription", flattenSubscriptions(version.Subscriptions)); err != nil {
+			return diag.Errorf("error setting subscription: %s", err)
+		}
+	} else {
+		d.Set("subscription", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSubscriptionDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	if d.HasChange("name") {
+		input := &greengrass.UpdateSubscriptionDefinitionInput{
+			SubscriptionDefinitionId: aws.String(d.Id()),
+			Name:                     aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Greengrass Subscription Definition: %s", input)
+		_, err := conn.UpdateSubscriptionDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Greengrass Subscription Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("subscription") {
+		input := &greengrass.CreateSubscriptionDefinitionVersionInput{
+			SubscriptionDefinitionId: aws.String(d.Id()),
+			Subscriptions:            expandSubscriptions(d.Get("subscription").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Creating Greengrass Subscription Definition Version: %s", input)
+		_, err := conn.CreateSubscriptionDefinitionVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Greengrass Subscription Definition (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass Subscription Definition (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSubscriptionDefinitionRead(ctx, d, meta)
+}
+
+func resourceSubscriptionDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassConn
+
+	log.Printf("[DEBUG] Deleting Greengrass Subscription Definition: %s", d.Id())
+	_, err := conn.DeleteSubscriptionDefinitionWithContext(ctx, &greengrass.DeleteSubscriptionDefinitionInput{
+		SubscriptionDefinitionId: aws.String(d.Id()),
+	})
+
+	if isNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass Subscription Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSubscriptions(tfList []interface{}) []*greengrass.Subscription {
+	var apiObjects []*greengrass.Subscription
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrass.Subscription{}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.Source = aws.String(v)
+		}
+
+		if v, ok := tfMap["subject"].(string); ok && v != "" {
+			apiObject.Subject = aws.String(v)
+		}
+
+		if v, ok := tfMap["target"].(string); ok && v != "" {
+			apiObject.Target = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSubscriptions(apiObjects []*greengrass.Subscription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":      aws.StringValue(apiObject.Id),
+			"source":  aws.StringValue(apiObject.Source),
+			"subject": aws.StringValue(apiObject.Subject),
+			"target":  aws.StringValue(apiObject.Target),
+		})
+	}
+
+	return tfList
+}
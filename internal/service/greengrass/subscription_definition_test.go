@@ -0,0 +1,267 @@
+package greengrass_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrass "github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassSubscriptionDefinition_basic(t *testing.T) {
+	resourceName := "aws_greengrass_subscription_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSubscriptionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`/greengrass/definition/subscriptions/.+`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "latest_definition_version_arn", "greengrass", regexp.MustCompile(`/greengrass/definition/subscriptions/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscription.*", map[string]string{
+						"id":      "to-cloud",
+						"subject": "telemetry/#",
+						"target":  "cloud",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subscription.*.source", "aws_iot_thing.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassSubscriptionDefinition_disappears(t *testing.T) {
+	resourceName := "aws_greengrass_subscription_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSubscriptionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrass.ResourceSubscriptionDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassSubscriptionDefinition_tags(t *testing.T) {
+	resourceName := "aws_greengrass_subscription_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSubscriptionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSubscriptionDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSubscriptionDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassSubscriptionDefinition_update(t *testing.T) {
+	resourceName := "aws_greengrass_subscription_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrass.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrass.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSubscriptionDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccSubscriptionDefinitionConfig_updated(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriptionDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscription.*", map[string]string{
+						"id":      "from-cloud",
+						"source":  "cloud",
+						"subject": "commands/#",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriptionDefinitionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass Subscription Definition ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+		_, err := tfgreengrass.FindSubscriptionDefinitionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckSubscriptionDefinitionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrass_subscription_definition" {
+			continue
+		}
+
+		_, err := tfgreengrass.FindSubscriptionDefinitionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass Subscription Definition %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSubscriptionDefinitionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSubscriptionDefinitionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSubscriptionDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrass_subscription_definition" "test" {
+  name = %[1]q
+
+  subscription {
+    id      = "to-cloud"
+    source  = aws_iot_thing.test.arn
+    subject = "telemetry/#"
+    target  = "cloud"
+  }
+}
+`, rName))
+}
+
+func testAccSubscriptionDefinitionConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccSubscriptionDefinitionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrass_subscription_definition" "test" {
+  name = %[1]q
+
+  subscription {
+    id      = "to-cloud"
+    source  = aws_iot_thing.test.arn
+    subject = "telemetry/#"
+    target  = "cloud"
+  }
+
+  subscription {
+    id      = "from-cloud"
+    source  = "cloud"
+    subject = "commands/#"
+    target  = aws_iot_thing.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccSubscriptionDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_subscription_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSubscriptionDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrass_subscription_definition" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
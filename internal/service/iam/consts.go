@@ -3,3 +3,25 @@ package iam
 const (
 	policyModelMarshallJSONStartSliceSize = 2
 )
+
+const (
+	resourceHandlingOptionEC2ClassicEBS             = "EC2-Classic-EBS"
+	resourceHandlingOptionEC2ClassicInstanceStore   = "EC2-Classic-InstanceStore"
+	resourceHandlingOptionEC2VPCEBS                 = "EC2-VPC-EBS"
+	resourceHandlingOptionEC2VPCEBSSubnet           = "EC2-VPC-EBS-Subnet"
+	resourceHandlingOptionEC2VPCInstanceStore       = "EC2-VPC-InstanceStore"
+	resourceHandlingOptionEC2VPCInstanceStoreSubnet = "EC2-VPC-InstanceStore-Subnet"
+)
+
+// resourceHandlingOption_Values returns the EC2 scenarios accepted by the IAM policy simulator.
+// The SDK does not model these as an enum.
+func resourceHandlingOption_Values() []string {
+	return []string{
+		resourceHandlingOptionEC2ClassicEBS,
+		resourceHandlingOptionEC2ClassicInstanceStore,
+		resourceHandlingOptionEC2VPCEBS,
+		resourceHandlingOptionEC2VPCEBSSubnet,
+		resourceHandlingOptionEC2VPCInstanceStore,
+		resourceHandlingOptionEC2VPCInstanceStoreSubnet,
+	}
+}
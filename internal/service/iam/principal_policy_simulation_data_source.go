@@ -0,0 +1,370 @@
+package iam
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+				AtLeastOneOf: []string{"additional_policies_json", "policy_source_arn"},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(5, 256),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				AtLeastOneOf: []string{"additional_policies_json", "policy_source_arn"},
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"resource_handling_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceHandlingOption_Values(), false),
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	var results []*iam.EvaluationResult
+	var id string
+
+	if v, ok := d.GetOk("policy_source_arn"); ok {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+			ContextEntries:  expandPolicySimulationContextEntries(d.Get("context").(*schema.Set).List()),
+			PolicySourceArn: aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("additional_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+			input.PolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+			input.PermissionsBoundaryPolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_handling_option"); ok {
+			input.ResourceHandlingOption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		err := conn.SimulatePrincipalPolicyPages(input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			results = append(results, page.EvaluationResults...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error simulating IAM principal policy (%s): %w", v.(string), err)
+		}
+
+		id = input.String()
+	} else {
+		input := &iam.SimulateCustomPolicyInput{
+			ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+			ContextEntries:  expandPolicySimulationContextEntries(d.Get("context").(*schema.Set).List()),
+			PolicyInputList: flex.ExpandStringSet(d.Get("additional_policies_json").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+			input.PermissionsBoundaryPolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_handling_option"); ok {
+			input.ResourceHandlingOption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		err := conn.SimulateCustomPolicyPages(input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			results = append(results, page.EvaluationResults...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error simulating IAM custom policy: %w", err)
+		}
+
+		id = input.String()
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(id)))
+
+	tfList := flattenPolicySimulationEvaluationResults(results)
+
+	allAllowed := true
+	for _, tfMapRaw := range tfList {
+		if !tfMapRaw.(map[string]interface{})["allowed"].(bool) {
+			allAllowed = false
+			break
+		}
+	}
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", tfList); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	return nil
+}
+
+func expandPolicySimulationContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iam.ContextEntry{
+			ContextKeyName:   aws.String(tfMap["key"].(string)),
+			ContextKeyType:   aws.String(tfMap["type"].(string)),
+			ContextKeyValues: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenPolicySimulationEvaluationResults returns one result per action and resource.
+// When the simulator reports per-resource decisions, those replace the aggregate decision
+// so that a single denied resource is not hidden behind it.
+func flattenPolicySimulationEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		actionName := aws.StringValue(apiObject.EvalActionName)
+
+		if len(apiObject.ResourceSpecificResults) == 0 {
+			tfList = append(tfList, flattenPolicySimulationResult(actionName, apiObject.EvalResourceName, apiObject.EvalDecision, apiObject.EvalDecisionDetails, apiObject.MatchedStatements, apiObject.MissingContextValues))
+
+			continue
+		}
+
+		for _, v := range apiObject.ResourceSpecificResults {
+			if v == nil {
+				continue
+			}
+
+			tfList = append(tfList, flattenPolicySimulationResult(actionName, v.EvalResourceName, v.EvalResourceDecision, v.EvalDecisionDetails, v.MatchedStatements, v.MissingContextValues))
+		}
+	}
+
+	return tfList
+}
+
+func flattenPolicySimulationResult(actionName string, resourceName, decision *string, decisionDetails map[string]*string, matchedStatements []*iam.Statement, missingContextValues []*string) map[string]interface{} {
+	missingContextKeys := aws.StringValueSlice(missingContextValues)
+	sort.Strings(missingContextKeys)
+
+	return map[string]interface{}{
+		"action_name":          actionName,
+		"allowed":              aws.StringValue(decision) == iam.PolicyEvaluationDecisionTypeAllowed,
+		"decision":             aws.StringValue(decision),
+		"decision_details":     aws.StringValueMap(decisionDetails),
+		"matched_statements":   flattenPolicySimulationStatements(matchedStatements),
+		"missing_context_keys": missingContextKeys,
+		"resource_arn":         aws.StringValue(resourceName),
+	}
+}
+
+func flattenPolicySimulationStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		})
+	}
+
+	return tfList
+}
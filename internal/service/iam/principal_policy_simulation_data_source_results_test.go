@@ -0,0 +1,65 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func TestFlattenPolicySimulationEvaluationResults(t *testing.T) {
+	apiObjects := []*iam.EvaluationResult{
+		{
+			EvalActionName:   aws.String("s3:ListBucket"),
+			EvalDecision:     aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+			EvalResourceName: aws.String("arn:aws:s3:::bucket"), //lintignore:AWSAT005
+		},
+		{
+			EvalActionName:   aws.String("s3:GetObject"),
+			EvalDecision:     aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+			EvalResourceName: aws.String("*"),
+			ResourceSpecificResults: []*iam.ResourceSpecificResult{
+				{
+					EvalResourceDecision: aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+					EvalResourceName:     aws.String("arn:aws:s3:::bucket/a"), //lintignore:AWSAT005
+				},
+				{
+					EvalResourceDecision: aws.String(iam.PolicyEvaluationDecisionTypeExplicitDeny),
+					EvalResourceName:     aws.String("arn:aws:s3:::bucket/b"), //lintignore:AWSAT005
+				},
+			},
+		},
+	}
+
+	expected := []struct {
+		actionName  string
+		allowed     bool
+		resourceARN string
+	}{
+		{"s3:ListBucket", true, "arn:aws:s3:::bucket"},   //lintignore:AWSAT005
+		{"s3:GetObject", true, "arn:aws:s3:::bucket/a"},  //lintignore:AWSAT005
+		{"s3:GetObject", false, "arn:aws:s3:::bucket/b"}, //lintignore:AWSAT005
+	}
+
+	tfList := flattenPolicySimulationEvaluationResults(apiObjects)
+
+	if got, want := len(tfList), len(expected); got != want {
+		t.Fatalf("got %d results, expected %d", got, want)
+	}
+
+	for i, e := range expected {
+		tfMap := tfList[i].(map[string]interface{})
+
+		if got := tfMap["action_name"].(string); got != e.actionName {
+			t.Errorf("result %d: got action_name %q, expected %q", i, got, e.actionName)
+		}
+
+		if got := tfMap["allowed"].(bool); got != e.allowed {
+			t.Errorf("result %d: got allowed %t, expected %t", i, got, e.allowed)
+		}
+
+		if got := tfMap["resource_arn"].(string); got != e.resourceARN {
+			t.Errorf("result %d: got resource_arn %q, expected %q", i, got, e.resourceARN)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPolicySimulationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allowedDataSourceName := "data.aws_iam_principal_policy_simulation.allowed"
+	deniedDataSourceName := "data.aws_iam_principal_policy_simulation.denied"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allowedDataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.matched_statements.0.source_policy_type", "IAM Policy"),
+					resource.TestCheckResourceAttr(deniedDataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(deniedDataSourceName, "results.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_custom(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_custom(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "ec2:DescribeInstances"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:ListBucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::my-test-bucket/*"
+    }]
+  })
+}
+
+data "aws_iam_principal_policy_simulation" "allowed" {
+  action_names      = ["s3:GetObject"]
+  policy_source_arn = aws_iam_role.test.arn
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::my-test-bucket/foo"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+data "aws_iam_principal_policy_simulation" "denied" {
+  action_names      = ["s3:GetObject", "s3:PutObject"]
+  policy_source_arn = aws_iam_role.test.arn
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::my-test-bucket/foo"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_custom() string {
+	return `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:ListBucket"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names             = ["ec2:DescribeInstances", "s3:ListBucket"]
+  additional_policies_json = [data.aws_iam_policy_document.test.json]
+}
+`
+}
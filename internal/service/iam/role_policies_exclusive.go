@@ -0,0 +1,160 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePoliciesExclusivePut,
+		Read:   resourceRolePoliciesExclusiveRead,
+		Update: resourceRolePoliciesExclusivePut,
+		Delete: resourceRolePoliciesExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRolePoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validRolePolicyName,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	want := flex.ExpandStringSet(d.Get("policy_names").(*schema.Set))
+
+	if err := syncRolePolicies(conn, roleName, want); err != nil {
+		return fmt.Errorf("error synchronizing IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Id()
+
+	_, err := FindRoleByName(conn, roleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing inline policies exclusive management from state", roleName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", roleName, err)
+	}
+
+	policyNames, err := readRolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error listing IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	d.Set("role_name", roleName)
+
+	if err := d.Set("policy_names", flex.FlattenStringSet(policyNames)); err != nil {
+		return fmt.Errorf("error setting policy_names: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Inline policies are owned by their aws_iam_role_policy resources, so
+	// releasing exclusive management leaves them in place.
+	log.Printf("[DEBUG] Releasing exclusive management of IAM Role (%s) inline policies", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncRolePolicies deletes any inline policy on the role that is not in want.
+// Policies in want are expected to be created by other means, typically
+// aws_iam_role_policy resources, and an error is returned if any are missing.
+func syncRolePolicies(conn *iam.IAM, roleName string, want []*string) error {
+	have, err := readRolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]struct{}, len(have))
+	for _, name := range have {
+		existing[aws.StringValue(name)] = struct{}{}
+	}
+
+	wanted := make(map[string]struct{}, len(want))
+	var missing []string
+	for _, name := range want {
+		wanted[aws.StringValue(name)] = struct{}{}
+
+		if _, ok := existing[aws.StringValue(name)]; !ok {
+			missing = append(missing, aws.StringValue(name))
+		}
+	}
+
+	// This resource does not create inline policies, so every listed policy must already exist.
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		return fmt.Errorf("inline policies not found: %s; reference the aws_iam_role_policy resources (or add depends_on) so they are created first", strings.Join(missing, ", "))
+	}
+
+	for _, name := range have {
+		if _, ok := wanted[aws.StringValue(name)]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy not under management: %s", roleName, aws.StringValue(name))
+		_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			PolicyName: name,
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting inline policy (%s): %w", aws.StringValue(name), err)
+		}
+	}
+
+	return nil
+}
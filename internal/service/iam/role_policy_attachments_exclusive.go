@@ -0,0 +1,157 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePolicyAttachmentsExclusivePut,
+		Read:   resourceRolePolicyAttachmentsExclusiveRead,
+		Update: resourceRolePolicyAttachmentsExclusivePut,
+		Delete: resourceRolePolicyAttachmentsExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRolePolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	want := flex.ExpandStringSet(d.Get("policy_arns").(*schema.Set))
+
+	if err := syncRolePolicyAttachments(conn, roleName, want); err != nil {
+		return fmt.Errorf("error synchronizing IAM Role (%s) managed policy attachments: %w", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Id()
+
+	_, err := FindRoleByName(conn, roleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing managed policy attachments exclusive management from state", roleName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", roleName, err)
+	}
+
+	policyARNs, err := readRolePolicyAttachments(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error listing IAM Role (%s) managed policy attachments: %w", roleName, err)
+	}
+
+	d.Set("role_name", roleName)
+
+	if err := d.Set("policy_arns", flex.FlattenStringSet(policyARNs)); err != nil {
+		return fmt.Errorf("error setting policy_arns: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Attachments may also be declared by aws_iam_role_policy_attachment
+	// resources, so releasing exclusive management leaves them in place.
+	log.Printf("[DEBUG] Releasing exclusive management of IAM Role (%s) managed policy attachments", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncRolePolicyAttachments detaches any attached policy that is not in want.
+// Every policy in want must already be attached to the role.
+func syncRolePolicyAttachments(conn *iam.IAM, roleName string, want []*string) error {
+	have, err := readRolePolicyAttachments(conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	attached := make(map[string]struct{}, len(have))
+	for _, arn := range have {
+		attached[aws.StringValue(arn)] = struct{}{}
+	}
+
+	wanted := make(map[string]struct{}, len(want))
+	var missing []string
+	for _, arn := range want {
+		wanted[aws.StringValue(arn)] = struct{}{}
+
+		if _, ok := attached[aws.StringValue(arn)]; !ok {
+			missing = append(missing, aws.StringValue(arn))
+		}
+	}
+
+	// This resource does not attach policies, so every listed policy must already be attached.
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		return fmt.Errorf("managed policies not attached: %s; reference the aws_iam_role_policy_attachment resources (or add depends_on) so they are attached first", strings.Join(missing, ", "))
+	}
+
+	for _, arn := range have {
+		if _, ok := wanted[aws.StringValue(arn)]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Detaching IAM Role (%s) managed policy not under management: %s", roleName, aws.StringValue(arn))
+		err := DetachPolicyFromRole(conn, roleName, aws.StringValue(arn))
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error detaching policy (%s): %w", aws.StringValue(arn), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package identitystore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func isResourceNotFoundErr(err error) bool {
+	var resourceNotFound *types.ResourceNotFoundException
+
+	return errors.As(err, &resourceNotFound)
+}
+
+func FindGroupByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (*identitystore.DescribeGroupOutput, error) {
+	input := &identitystore.DescribeGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	output, err := conn.DescribeGroup(ctx, input)
+
+	if isResourceNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindGroupMembershipByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, membershipID string) (*identitystore.DescribeGroupMembershipOutput, error) {
+	input := &identitystore.DescribeGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	}
+
+	output, err := conn.DescribeGroupMembership(ctx, input)
+
+	if isResourceNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindUserByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, userID string) (*identitystore.DescribeUserOutput, error) {
+	input := &identitystore.DescribeUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	}
+
+	output, err := conn.DescribeUser(ctx, input)
+
+	if isResourceNotFoundErr(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
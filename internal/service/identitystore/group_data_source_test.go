@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -23,7 +22,7 @@ func TestAccIdentityStoreGroupDataSource_displayName(t *testing.T) {
 			testAccPreCheckSSOAdminInstances(t)
 			testAccPreCheckGroupName(t)
 		},
-		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ErrorCheck:   acctest.ErrorCheck(t, conns.IdentityStoreEndpointID),
 		Providers:    acctest.Providers,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
@@ -50,7 +49,7 @@ func TestAccIdentityStoreGroupDataSource_groupID(t *testing.T) {
 			testAccPreCheckGroupName(t)
 			testAccPreCheckGroupID(t)
 		},
-		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ErrorCheck:   acctest.ErrorCheck(t, conns.IdentityStoreEndpointID),
 		Providers:    acctest.Providers,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
@@ -68,7 +67,7 @@ func TestAccIdentityStoreGroupDataSource_groupID(t *testing.T) {
 func TestAccIdentityStoreGroupDataSource_nonExistent(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ErrorCheck:   acctest.ErrorCheck(t, conns.IdentityStoreEndpointID),
 		Providers:    acctest.Providers,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
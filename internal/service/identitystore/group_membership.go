@@ -0,0 +1,162 @@
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipRead,
+		DeleteWithoutTimeout: resourceGroupMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+				),
+			},
+			"member_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateGroupMembershipInput{
+		GroupId:         aws.String(d.Get("group_id").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		MemberId: &types.MemberIdMemberUserId{
+			Value: d.Get("member_id").(string),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store Group Membership: %+v", input)
+	output, err := conn.CreateGroupMembership(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Identity Store Group Membership: %s", err)
+	}
+
+	d.SetId(GroupMembershipCreateResourceID(identityStoreID, aws.ToString(output.MembershipId)))
+
+	return resourceGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, membershipID, err := GroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindGroupMembershipByTwoPartKey(ctx, conn, identityStoreID, membershipID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Identity Store Group Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("group_id", output.GroupId)
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("membership_id", output.MembershipId)
+
+	switch v := output.MemberId.(type) {
+	case *types.MemberIdMemberUserId:
+		d.Set("member_id", v.Value)
+	default:
+		return diag.Errorf("error reading Identity Store Group Membership (%s): unsupported member ID type %T", d.Id(), v)
+	}
+
+	return nil
+}
+
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, membershipID, err := GroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store Group Membership: %s", d.Id())
+	_, err = conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	})
+
+	if isResourceNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Identity Store Group Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const groupMembershipResourceIDSeparator = "/"
+
+func GroupMembershipCreateResourceID(identityStoreID, membershipID string) string {
+	parts := []string{identityStoreID, membershipID}
+	id := strings.Join(parts, groupMembershipResourceIDSeparator)
+
+	return id
+}
+
+func GroupMembershipParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected identity-store-id%[2]smembership-id", id, groupMembershipResourceIDSeparator)
+}
@@ -0,0 +1,843 @@
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserCreate,
+		ReadWithoutTimeout:   resourceUserRead,
+		UpdateWithoutTimeout: resourceUserUpdate,
+		DeleteWithoutTimeout: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"locality": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"postal_code": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"street_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"external_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+				),
+			},
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"family_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"given_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_suffix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"middle_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"nickname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"phone_numbers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"preferred_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"profile_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"user_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+// userAttributePaths maps the top-level string arguments to their Identity Store attribute paths.
+var userAttributePaths = map[string]string{
+	"display_name":       "displayName",
+	"locale":             "locale",
+	"nickname":           "nickName",
+	"preferred_language": "preferredLanguage",
+	"profile_url":        "profileUrl",
+	"timezone":           "timezone",
+	"title":              "title",
+	"user_type":          "userType",
+}
+
+// userNameAttributePaths maps the name block's arguments to their Identity Store attribute paths.
+var userNameAttributePaths = map[string]string{
+	"family_name":      "name.familyName",
+	"formatted":        "name.formatted",
+	"given_name":       "name.givenName",
+	"honorific_prefix": "name.honorificPrefix",
+	"honorific_suffix": "name.honorificSuffix",
+	"middle_name":      "name.middleName",
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateUserInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		UserName:        aws.String(d.Get("user_name").(string)),
+	}
+
+	if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 {
+		input.Addresses = expandAddresses(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 {
+		input.Emails = expandEmails(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Name = expandName(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("nickname"); ok {
+		input.NickName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 {
+		input.PhoneNumbers = expandPhoneNumbers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("preferred_language"); ok {
+		input.PreferredLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("profile_url"); ok {
+		input.ProfileUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		input.UserType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store User: %+v", input)
+	output, err := conn.CreateUser(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Identity Store User (%s): %s", d.Get("user_name").(string), err)
+	}
+
+	d.SetId(UserCreateResourceID(identityStoreID, aws.ToString(output.UserId)))
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Identity Store User (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("addresses", flattenAddresses(output.Addresses)); err != nil {
+		return diag.Errorf("error setting addresses: %s", err)
+	}
+
+	d.Set("display_name", output.DisplayName)
+
+	if err := d.Set("emails", flattenEmails(output.Emails)); err != nil {
+		return diag.Errorf("error setting emails: %s", err)
+	}
+
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return diag.Errorf("error setting external_ids: %s", err)
+	}
+
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("locale", output.Locale)
+
+	if output.Name != nil {
+		if err := d.Set("name", []interface{}{flattenName(output.Name)}); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+	} else {
+		d.Set("name", nil)
+	}
+
+	d.Set("nickname", output.NickName)
+
+	if err := d.Set("phone_numbers", flattenPhoneNumbers(output.PhoneNumbers)); err != nil {
+		return diag.Errorf("error setting phone_numbers: %s", err)
+	}
+
+	d.Set("preferred_language", output.PreferredLanguage)
+	d.Set("profile_url", output.ProfileUrl)
+	d.Set("timezone", output.Timezone)
+	d.Set("title", output.Title)
+	d.Set("user_id", output.UserId)
+	d.Set("user_name", output.UserName)
+	d.Set("user_type", output.UserType)
+
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &identitystore.UpdateUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	}
+
+	for key, path := range userAttributePaths {
+		if d.HasChange(key) {
+			input.Operations = append(input.Operations, stringAttributeOperation(path, d.Get(key).(string)))
+		}
+	}
+
+	for key, path := range userNameAttributePaths {
+		if k := "name.0." + key; d.HasChange(k) {
+			input.Operations = append(input.Operations, stringAttributeOperation(path, d.Get(k).(string)))
+		}
+	}
+
+	if d.HasChange("addresses") {
+		operation := types.AttributeOperation{
+			AttributePath: aws.String("addresses"),
+		}
+
+		if v := d.Get("addresses").([]interface{}); len(v) > 0 {
+			operation.AttributeValue = document.NewLazyDocument(expandAddressesDocument(v))
+		}
+
+		input.Operations = append(input.Operations, operation)
+	}
+
+	if d.HasChange("emails") {
+		operation := types.AttributeOperation{
+			AttributePath: aws.String("emails"),
+		}
+
+		if v := d.Get("emails").([]interface{}); len(v) > 0 {
+			operation.AttributeValue = document.NewLazyDocument(expandEmailsDocument(v))
+		}
+
+		input.Operations = append(input.Operations, operation)
+	}
+
+	if d.HasChange("phone_numbers") {
+		operation := types.AttributeOperation{
+			AttributePath: aws.String("phoneNumbers"),
+		}
+
+		if v := d.Get("phone_numbers").([]interface{}); len(v) > 0 {
+			operation.AttributeValue = document.NewLazyDocument(expandPhoneNumbersDocument(v))
+		}
+
+		input.Operations = append(input.Operations, operation)
+	}
+
+	if len(input.Operations) > 0 {
+		log.Printf("[DEBUG] Updating Identity Store User: %+v", input)
+		_, err := conn.UpdateUser(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Identity Store User (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store User: %s", d.Id())
+	_, err = conn.DeleteUser(ctx, &identitystore.DeleteUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	})
+
+	if isResourceNotFoundErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Identity Store User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const userResourceIDSeparator = "/"
+
+func UserCreateResourceID(identityStoreID, userID string) string {
+	parts := []string{identityStoreID, userID}
+	id := strings.Join(parts, userResourceIDSeparator)
+
+	return id
+}
+
+func UserParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected identity-store-id%[2]suser-id", id, userResourceIDSeparator)
+}
+
+// stringAttributeOperation returns an operation that sets the attribute at path to v,
+// or removes the attribute when v is empty.
+func stringAttributeOperation(path, v string) types.AttributeOperation {
+	operation := types.AttributeOperation{
+		AttributePath: aws.String(path),
+	}
+
+	if v != "" {
+		operation.AttributeValue = document.NewLazyDocument(v)
+	}
+
+	return operation
+}
+
+func expandName(tfMap map[string]interface{}) *types.Name {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Name{}
+
+	if v, ok := tfMap["family_name"].(string); ok && v != "" {
+		apiObject.FamilyName = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["given_name"].(string); ok && v != "" {
+		apiObject.GivenName = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_prefix"].(string); ok && v != "" {
+		apiObject.HonorificPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_suffix"].(string); ok && v != "" {
+		apiObject.HonorificSuffix = aws.String(v)
+	}
+
+	if v, ok := tfMap["middle_name"].(string); ok && v != "" {
+		apiObject.MiddleName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenName(apiObject *types.Name) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"family_name":      aws.ToString(apiObject.FamilyName),
+		"formatted":        aws.ToString(apiObject.Formatted),
+		"given_name":       aws.ToString(apiObject.GivenName),
+		"honorific_prefix": aws.ToString(apiObject.HonorificPrefix),
+		"honorific_suffix": aws.ToString(apiObject.HonorificSuffix),
+		"middle_name":      aws.ToString(apiObject.MiddleName),
+	}
+}
+
+func expandAddresses(tfList []interface{}) []types.Address {
+	var apiObjects []types.Address
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Address{}
+
+		if v, ok := tfMap["country"].(string); ok && v != "" {
+			apiObject.Country = aws.String(v)
+		}
+
+		if v, ok := tfMap["formatted"].(string); ok && v != "" {
+			apiObject.Formatted = aws.String(v)
+		}
+
+		if v, ok := tfMap["locality"].(string); ok && v != "" {
+			apiObject.Locality = aws.String(v)
+		}
+
+		if v, ok := tfMap["postal_code"].(string); ok && v != "" {
+			apiObject.PostalCode = aws.String(v)
+		}
+
+		if v, ok := tfMap["primary"].(bool); ok {
+			apiObject.Primary = v
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap["street_address"].(string); ok && v != "" {
+			apiObject.StreetAddress = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandAddressesDocument returns the attribute value used to replace a user's addresses.
+func expandAddressesDocument(tfList []interface{}) []interface{} {
+	var values []interface{}
+
+	for _, apiObject := range expandAddresses(tfList) {
+		value := map[string]interface{}{
+			"primary": apiObject.Primary,
+		}
+
+		if v := apiObject.Country; v != nil {
+			value["country"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Formatted; v != nil {
+			value["formatted"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Locality; v != nil {
+			value["locality"] = aws.ToString(v)
+		}
+
+		if v := apiObject.PostalCode; v != nil {
+			value["postalCode"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Region; v != nil {
+			value["region"] = aws.ToString(v)
+		}
+
+		if v := apiObject.StreetAddress; v != nil {
+			value["streetAddress"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Type; v != nil {
+			value["type"] = aws.ToString(v)
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func flattenAddresses(apiObjects []types.Address) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"country":        aws.ToString(apiObject.Country),
+			"formatted":      aws.ToString(apiObject.Formatted),
+			"locality":       aws.ToString(apiObject.Locality),
+			"postal_code":    aws.ToString(apiObject.PostalCode),
+			"primary":        apiObject.Primary,
+			"region":         aws.ToString(apiObject.Region),
+			"street_address": aws.ToString(apiObject.StreetAddress),
+			"type":           aws.ToString(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func expandEmails(tfList []interface{}) []types.Email {
+	var apiObjects []types.Email
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Email{}
+
+		if v, ok := tfMap["primary"].(bool); ok {
+			apiObject.Primary = v
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandEmailsDocument returns the attribute value used to replace a user's emails.
+func expandEmailsDocument(tfList []interface{}) []interface{} {
+	var values []interface{}
+
+	for _, apiObject := range expandEmails(tfList) {
+		values = append(values, expandPrimaryTypeValueDocument(apiObject.Primary, apiObject.Type, apiObject.Value))
+	}
+
+	return values
+}
+
+func flattenEmails(apiObjects []types.Email) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"primary": apiObject.Primary,
+			"type":    aws.ToString(apiObject.Type),
+			"value":   aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandPhoneNumbers(tfList []interface{}) []types.PhoneNumber {
+	var apiObjects []types.PhoneNumber
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PhoneNumber{}
+
+		if v, ok := tfMap["primary"].(bool); ok {
+			apiObject.Primary = v
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandPhoneNumbersDocument returns the attribute value used to replace a user's phone numbers.
+func expandPhoneNumbersDocument(tfList []interface{}) []interface{} {
+	var values []interface{}
+
+	for _, apiObject := range expandPhoneNumbers(tfList) {
+		values = append(values, expandPrimaryTypeValueDocument(apiObject.Primary, apiObject.Type, apiObject.Value))
+	}
+
+	return values
+}
+
+func flattenPhoneNumbers(apiObjects []types.PhoneNumber) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"primary": apiObject.Primary,
+			"type":    aws.ToString(apiObject.Type),
+			"value":   aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandPrimaryTypeValueDocument(primary bool, typ, value *string) map[string]interface{} {
+	m := map[string]interface{}{
+		"primary": primary,
+	}
+
+	if typ != nil {
+		m["type"] = aws.ToString(typ)
+	}
+
+	if value != nil {
+		m["value"] = aws.ToString(value)
+	}
+
+	return m
+}
+
+func flattenExternalIDs(apiObjects []types.ExternalId) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"id":     aws.ToString(apiObject.Id),
+			"issuer": aws.ToString(apiObject.Issuer),
+		})
+	}
+
+	return tfList
+}
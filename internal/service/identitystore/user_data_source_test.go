@@ -6,9 +6,9 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestAccIdentityStoreUserDataSource_userName(t *testing.T) {
@@ -21,7 +21,7 @@ func TestAccIdentityStoreUserDataSource_userName(t *testing.T) {
 			testAccPreCheckSSOAdminInstances(t)
 			testAccPreCheckUserName(t)
 		},
-		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ErrorCheck:   acctest.ErrorCheck(t, conns.IdentityStoreEndpointID),
 		Providers:    acctest.Providers,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
@@ -48,7 +48,7 @@ func TestAccIdentityStoreUserDataSource_userID(t *testing.T) {
 			testAccPreCheckUserName(t)
 			testAccPreCheckUserID(t)
 		},
-		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ErrorCheck:   acctest.ErrorCheck(t, conns.IdentityStoreEndpointID),
 		Providers:    acctest.Providers,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
@@ -66,7 +66,7 @@ func TestAccIdentityStoreUserDataSource_userID(t *testing.T) {
 func TestAccIdentityStoreUserDataSource_nonExistent(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ErrorCheck:   acctest.ErrorCheck(t, conns.IdentityStoreEndpointID),
 		Providers:    acctest.Providers,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
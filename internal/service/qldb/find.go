@@ -0,0 +1,56 @@
+package qldb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindStreamByTwoPartKey(ctx context.Context, conn *qldb.QLDB, ledgerName, streamID string) (*qldb.JournalKinesisStreamDescription, error) {
+	input := &qldb.DescribeJournalKinesisStreamInput{
+		LedgerName: aws.String(ledgerName),
+		StreamId:   aws.String(streamID),
+	}
+
+	output, err := findJournalKinesisStream(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// See https://docs.aws.amazon.com/qldb/latest/developerguide/streams.create.html#streams.create.states.
+	switch status := aws.StringValue(output.Status); status {
+	case qldb.StreamStatusCompleted, qldb.StreamStatusCanceled, qldb.StreamStatusFailed:
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findJournalKinesisStream(ctx context.Context, conn *qldb.QLDB, input *qldb.DescribeJournalKinesisStreamInput) (*qldb.JournalKinesisStreamDescription, error) {
+	output, err := conn.DescribeJournalKinesisStreamWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qldb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Stream == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Stream, nil
+}
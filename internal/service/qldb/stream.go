@@ -0,0 +1,326 @@
+package qldb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStream() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamCreate,
+		ReadWithoutTimeout:   resourceStreamRead,
+		UpdateWithoutTimeout: resourceStreamUpdate,
+		DeleteWithoutTimeout: resourceStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(streamCreatedTimeout),
+			Delete: schema.DefaultTimeout(streamDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclusive_end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"inclusive_start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"kinesis_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregation_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"ledger_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]+`), "must contain only alphanumeric characters, underscores, and hyphens"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]+`), "must contain only alphanumeric characters, underscores, and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	ledgerName := d.Get("ledger_name").(string)
+	name := d.Get("stream_name").(string)
+	input := &qldb.StreamJournalToKinesisInput{
+		LedgerName: aws.String(ledgerName),
+		RoleArn:    aws.String(d.Get("role_arn").(string)),
+		StreamName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("exclusive_end_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExclusiveEndTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("inclusive_start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.InclusiveStartTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("kinesis_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.KinesisConfiguration = expandKinesisConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating QLDB Stream: %s", input)
+	output, err := conn.StreamJournalToKinesisWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QLDB Stream (%s): %s", name, err)
+	}
+
+	streamID := aws.StringValue(output.StreamId)
+	d.SetId(StreamCreateResourceID(ledgerName, streamID))
+
+	if _, err := waitStreamCreated(ctx, conn, ledgerName, streamID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for QLDB Stream (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceStreamRead(ctx, d, meta)
+}
+
+func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ledgerName, streamID, err := StreamParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stream, err := FindStreamByTwoPartKey(ctx, conn, ledgerName, streamID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QLDB Stream %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QLDB Stream (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(stream.Arn)
+	d.Set("arn", arn)
+	if stream.ExclusiveEndTime != nil {
+		d.Set("exclusive_end_time", aws.TimeValue(stream.ExclusiveEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("exclusive_end_time", nil)
+	}
+	if stream.InclusiveStartTime != nil {
+		d.Set("inclusive_start_time", aws.TimeValue(stream.InclusiveStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("inclusive_start_time", nil)
+	}
+	if stream.KinesisConfiguration != nil {
+		if err := d.Set("kinesis_configuration", []interface{}{flattenKinesisConfiguration(stream.KinesisConfiguration)}); err != nil {
+			return diag.Errorf("error setting kinesis_configuration: %s", err)
+		}
+	} else {
+		d.Set("kinesis_configuration", nil)
+	}
+	d.Set("ledger_name", stream.LedgerName)
+	d.Set("role_arn", stream.RoleArn)
+	d.Set("stream_id", stream.StreamId)
+	d.Set("stream_name", stream.StreamName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QLDB Stream (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QLDB Stream (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceStreamRead(ctx, d, meta)
+}
+
+func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBConn
+
+	ledgerName, streamID, err := StreamParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QLDB Stream: %s", d.Id())
+	_, err = conn.CancelJournalKinesisStreamWithContext(ctx, &qldb.CancelJournalKinesisStreamInput{
+		LedgerName: aws.String(ledgerName),
+		StreamId:   aws.String(streamID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qldb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QLDB Stream (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitStreamDeleted(ctx, conn, ledgerName, streamID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for QLDB Stream (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const streamResourceIDSeparator = "/"
+
+func StreamCreateResourceID(ledgerName, streamID string) string {
+	parts := []string{ledgerName, streamID}
+	id := strings.Join(parts, streamResourceIDSeparator)
+
+	return id
+}
+
+func StreamParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, streamResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ledger-name%[2]sstream-id", id, streamResourceIDSeparator)
+}
+
+func expandKinesisConfiguration(tfMap map[string]interface{}) *qldb.KinesisConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &qldb.KinesisConfiguration{}
+
+	if v, ok := tfMap["aggregation_enabled"].(bool); ok {
+		apiObject.AggregationEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["stream_arn"].(string); ok && v != "" {
+		apiObject.StreamArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenKinesisConfiguration(apiObject *qldb.KinesisConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AggregationEnabled; v != nil {
+		tfMap["aggregation_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.StreamArn; v != nil {
+		tfMap["stream_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,288 @@
+package qldb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/qldb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqldb "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQLDBStream_basic(t *testing.T) {
+	var v qldb.JournalKinesisStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_stream.test"
+	startTime := time.Now().UTC().Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(qldb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, qldb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConfig_basic(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "qldb", regexp.MustCompile(`stream/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_end_time", ""),
+					resource.TestCheckResourceAttr(resourceName, "inclusive_start_time", startTime),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.0.aggregation_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_configuration.0.stream_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "ledger_name", "aws_qldb_ledger.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "stream_id"),
+					resource.TestCheckResourceAttr(resourceName, "stream_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQLDBStream_disappears(t *testing.T) {
+	var v qldb.JournalKinesisStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_stream.test"
+	startTime := time.Now().UTC().Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(qldb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, qldb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfqldb.ResourceStream(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQLDBStream_tags(t *testing.T) {
+	var v qldb.JournalKinesisStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_stream.test"
+	startTime := time.Now().UTC().Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(qldb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, qldb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConfig_tags1(rName, startTime, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStreamConfig_tags2(rName, startTime, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccStreamConfig_tags1(rName, startTime, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStreamDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_qldb_stream" {
+			continue
+		}
+
+		ledgerName, streamID, err := tfqldb.StreamParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfqldb.FindStreamByTwoPartKey(context.TODO(), conn, ledgerName, streamID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QLDB Stream %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckStreamExists(n string, v *qldb.JournalKinesisStreamDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QLDB Stream ID is set")
+		}
+
+		ledgerName, streamID, err := tfqldb.StreamParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBConn
+
+		output, err := tfqldb.FindStreamByTwoPartKey(context.TODO(), conn, ledgerName, streamID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccStreamConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "ALLOW_ALL"
+  deletion_protection = false
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qldb.amazonaws.com"
+      }
+    }]
+  })
+
+  inline_policy {
+    name = "test-qldb-policy"
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action = [
+          "kinesis:PutRecord*",
+          "kinesis:DescribeStream",
+          "kinesis:ListShards",
+        ]
+        Effect   = "Allow"
+        Resource = aws_kinesis_stream.test.arn
+      }]
+    })
+  }
+}
+`, rName)
+}
+
+func testAccStreamConfig_basic(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccStreamConfigBase(rName), fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  stream_name          = %[1]q
+  ledger_name          = aws_qldb_ledger.test.id
+  inclusive_start_time = %[2]q
+  role_arn             = aws_iam_role.test.arn
+
+  kinesis_configuration {
+    stream_arn = aws_kinesis_stream.test.arn
+  }
+}
+`, rName, startTime))
+}
+
+func testAccStreamConfig_tags1(rName, startTime, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccStreamConfigBase(rName), fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  stream_name          = %[1]q
+  ledger_name          = aws_qldb_ledger.test.id
+  inclusive_start_time = %[2]q
+  role_arn             = aws_iam_role.test.arn
+
+  kinesis_configuration {
+    stream_arn = aws_kinesis_stream.test.arn
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, startTime, tagKey1, tagValue1))
+}
+
+func testAccStreamConfig_tags2(rName, startTime, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccStreamConfigBase(rName), fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  stream_name          = %[1]q
+  ledger_name          = aws_qldb_ledger.test.id
+  inclusive_start_time = %[2]q
+  role_arn             = aws_iam_role.test.arn
+
+  kinesis_configuration {
+    stream_arn = aws_kinesis_stream.test.arn
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, startTime, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,61 @@
+package qldb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	streamCreatedTimeout = 8 * time.Minute
+	streamDeletedTimeout = 5 * time.Minute
+)
+
+func waitStreamCreated(ctx context.Context, conn *qldb.QLDB, ledgerName, streamID string, timeout time.Duration) (*qldb.JournalKinesisStreamDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{qldb.StreamStatusImpaired},
+		Target:     []string{qldb.StreamStatusActive},
+		Refresh:    statusStreamCreated(ctx, conn, ledgerName, streamID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qldb.JournalKinesisStreamDescription); ok {
+		if v := output.ErrorCause; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamDeleted(ctx context.Context, conn *qldb.QLDB, ledgerName, streamID string, timeout time.Duration) (*qldb.JournalKinesisStreamDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{qldb.StreamStatusActive, qldb.StreamStatusImpaired},
+		Target:     []string{},
+		Refresh:    statusStreamDeleted(ctx, conn, ledgerName, streamID),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qldb.JournalKinesisStreamDescription); ok {
+		if v := output.ErrorCause; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
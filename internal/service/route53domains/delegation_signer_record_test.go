@@ -0,0 +1,198 @@
+package route53domains_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccRoute53DomainsDelegationSignerRecord_basic(t *testing.T) {
+	key := "ROUTE53DOMAINS_DOMAIN_NAME"
+	domainName := os.Getenv(key)
+	if domainName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_route53domains_delegation_signer_record.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckRoute53Domains(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, conns.Route53DomainsEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDelegationSignerRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegationSignerRecordConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegationSignerRecordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "signing_attributes.0.algorithm", "13"),
+					resource.TestCheckResourceAttr(resourceName, "signing_attributes.0.flags", "257"),
+					resource.TestCheckResourceAttrPair(resourceName, "signing_attributes.0.public_key", "aws_route53_key_signing_key.test", "public_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRoute53DomainsDelegationSignerRecord_disappears(t *testing.T) {
+	key := "ROUTE53DOMAINS_DOMAIN_NAME"
+	domainName := os.Getenv(key)
+	if domainName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_route53domains_delegation_signer_record.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckRoute53Domains(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, conns.Route53DomainsEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDelegationSignerRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegationSignerRecordConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegationSignerRecordExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53domains.ResourceDelegationSignerRecord(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDelegationSignerRecordDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53DomainsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53domains_delegation_signer_record" {
+			continue
+		}
+
+		domainName, dnssecKeyID, err := tfroute53domains.DelegationSignerRecordParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfroute53domains.FindDNSSECKeyByTwoPartKey(context.TODO(), conn, domainName, dnssecKeyID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Domains Delegation Signer Record %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDelegationSignerRecordExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Domains Delegation Signer Record ID is set")
+		}
+
+		domainName, dnssecKeyID, err := tfroute53domains.DelegationSignerRecordParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53DomainsConn
+
+		_, err = tfroute53domains.FindDNSSECKeyByTwoPartKey(context.TODO(), conn, domainName, dnssecKeyID)
+
+		return err
+	}
+}
+
+func testAccDelegationSignerRecordConfig_basic(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  customer_master_key_spec = "ECC_NIST_P256"
+  deletion_window_in_days  = 7
+  key_usage                = "SIGN_VERIFY"
+  policy = jsonencode({
+    Statement = [
+      {
+        Action = [
+          "kms:DescribeKey",
+          "kms:GetPublicKey",
+          "kms:Sign",
+        ],
+        Effect = "Allow"
+        Principal = {
+          Service = "api-service.dnssec.route53.aws.internal"
+        }
+        Sid = "Allow Route 53 DNSSEC Service"
+      },
+      {
+        Action = "kms:*"
+        Effect = "Allow"
+        Principal = {
+          AWS = "*"
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  depends_on = [aws_route53_key_signing_key.test]
+
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+}
+
+resource "aws_route53domains_delegation_signer_record" "test" {
+  domain_name = %[2]q
+
+  signing_attributes {
+    algorithm  = aws_route53_key_signing_key.test.signing_algorithm_type
+    flags      = aws_route53_key_signing_key.test.flag
+    public_key = aws_route53_key_signing_key.test.public_key
+  }
+}
+`, rName, domainName)
+}
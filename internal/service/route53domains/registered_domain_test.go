@@ -15,6 +15,10 @@ import (
 
 func TestAccRoute53Domains_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"DelegationSignerRecord": {
+			"basic":      testAccRoute53DomainsDelegationSignerRecord_basic,
+			"disappears": testAccRoute53DomainsDelegationSignerRecord_disappears,
+		},
 		"RegisteredDomain": {
 			"tags":           testAccRoute53DomainsRegisteredDomain_tags,
 			"autoRenew":      testAccRoute53DomainsRegisteredDomain_autoRenew,
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,10 +34,10 @@ import (
 
 func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceBucketCreate,
-		Read:   resourceBucketRead,
-		Update: resourceBucketUpdate,
-		Delete: resourceBucketDelete,
+		Create:      resourceBucketCreate,
+		ReadContext: resourceBucketReadWithDriftCheck,
+		Update:      resourceBucketUpdate,
+		Delete:      resourceBucketDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -363,6 +364,15 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			"standalone_configurations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(BucketStandaloneConfiguration_Values(), false),
+				},
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1286,9 +1296,27 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}.String()
 	d.Set("arn", arn)
 
+	if err := clearBucketStandaloneConfigurations(d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func resourceBucketReadWithDriftCheck(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	prior := bucketInlineConfigurationState(d)
+
+	if err := resourceBucketRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	return bucketInlineConfigurationDrift(d, prior)
+}
+
 func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
@@ -0,0 +1,151 @@
+package s3
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var resourceNameInvalidCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+func DataSourceBucketConfigurationImports() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBucketConfigurationImportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"commands": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"imports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"configuration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`), "must be a valid Terraform resource name"),
+			},
+			"standalone_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceBucketConfigurationImportsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
+	resourceName := d.Get("resource_name").(string)
+	if resourceName == "" {
+		resourceName = bucketResourceName(bucket)
+	}
+
+	names, err := FindBucketInlineConfigurations(conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return err
+	}
+
+	var commands []string
+	var imports []interface{}
+	var standalone []string
+
+	for _, name := range names {
+		v := bucketInlineConfigurations[name]
+
+		importID := bucket
+		if v.importWithBucketOwner {
+			importID = CreateResourceID(bucket, expectedBucketOwner)
+		}
+
+		address := fmt.Sprintf("%s.%s", v.resourceType, resourceName)
+		command := fmt.Sprintf("terraform import %s %s", address, importID)
+
+		commands = append(commands, command)
+		imports = append(imports, map[string]interface{}{
+			"address":       address,
+			"command":       command,
+			"configuration": name,
+			"import_id":     importID,
+			"resource_type": v.resourceType,
+		})
+
+		if len(v.attributes) > 0 {
+			standalone = append(standalone, name)
+		}
+	}
+
+	d.SetId(bucket)
+	d.Set("resource_name", resourceName)
+
+	if err := d.Set("commands", commands); err != nil {
+		return fmt.Errorf("error setting commands: %w", err)
+	}
+
+	if err := d.Set("imports", imports); err != nil {
+		return fmt.Errorf("error setting imports: %w", err)
+	}
+
+	if err := d.Set("standalone_configurations", standalone); err != nil {
+		return fmt.Errorf("error setting standalone_configurations: %w", err)
+	}
+
+	return nil
+}
+
+// bucketResourceName returns a Terraform resource name derived from the bucket name.
+func bucketResourceName(bucket string) string {
+	name := resourceNameInvalidCharsRegexp.ReplaceAllString(bucket, "_")
+
+	// Resource names must start with a letter or underscore.
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "bucket_" + name
+	}
+
+	return name
+}
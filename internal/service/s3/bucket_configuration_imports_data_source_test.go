@@ -0,0 +1,83 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketConfigurationImportsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration_imports.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationImportsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_name", "example"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "commands.*", fmt.Sprintf("terraform import aws_s3_bucket_versioning.example %s", rName)),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "commands.*", fmt.Sprintf("terraform import aws_s3_bucket_policy.example %s", rName)),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "imports.*", map[string]string{
+						"address":       "aws_s3_bucket_versioning.example",
+						"configuration": "versioning",
+						"import_id":     rName,
+						"resource_type": "aws_s3_bucket_versioning",
+					}),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "standalone_configurations.*", "policy"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "standalone_configurations.*", "versioning"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketConfigurationImportsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Deny"
+      Principal = "*"
+      Action    = "s3:*"
+      Resource  = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        Bool = {
+          "aws:SecureTransport" = "false"
+        }
+      }
+    }]
+  })
+}
+
+data "aws_s3_bucket_configuration_imports" "test" {
+  bucket        = aws_s3_bucket.test.id
+  resource_name = "example"
+
+  depends_on = [
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_versioning.test,
+  ]
+}
+`, rName)
+}
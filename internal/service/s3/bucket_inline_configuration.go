@@ -0,0 +1,393 @@
+package s3
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// bucketInlineConfiguration describes a bucket configuration that is exposed
+// through deprecated aws_s3_bucket attributes and that is managed by a
+// standalone resource.
+type bucketInlineConfiguration struct {
+	// attributes are the aws_s3_bucket attributes holding the configuration.
+	// Configurations without attributes cannot be guarded against drift.
+	attributes []string
+
+	// resourceType is the standalone resource managing the configuration.
+	resourceType string
+
+	// importWithBucketOwner is whether the standalone resource's import ID
+	// accepts an expected bucket owner.
+	importWithBucketOwner bool
+}
+
+var bucketInlineConfigurations = map[string]bucketInlineConfiguration{
+	"acceleration_status": {
+		attributes:            []string{"acceleration_status"},
+		resourceType:          "aws_s3_bucket_accelerate_configuration",
+		importWithBucketOwner: true,
+	},
+	"acl": {
+		attributes:            []string{"acl", "grant"},
+		resourceType:          "aws_s3_bucket_acl",
+		importWithBucketOwner: true,
+	},
+	"cors_rule": {
+		attributes:            []string{"cors_rule"},
+		resourceType:          "aws_s3_bucket_cors_configuration",
+		importWithBucketOwner: true,
+	},
+	"lifecycle_rule": {
+		attributes:            []string{"lifecycle_rule"},
+		resourceType:          "aws_s3_bucket_lifecycle_configuration",
+		importWithBucketOwner: true,
+	},
+	"logging": {
+		attributes:            []string{"logging"},
+		resourceType:          "aws_s3_bucket_logging",
+		importWithBucketOwner: true,
+	},
+	"object_lock_configuration": {
+		resourceType:          "aws_s3_bucket_object_lock_configuration",
+		importWithBucketOwner: true,
+	},
+	"policy": {
+		attributes:   []string{"policy"},
+		resourceType: "aws_s3_bucket_policy",
+	},
+	"replication_configuration": {
+		attributes:   []string{"replication_configuration"},
+		resourceType: "aws_s3_bucket_replication_configuration",
+	},
+	"request_payer": {
+		attributes:            []string{"request_payer"},
+		resourceType:          "aws_s3_bucket_request_payment_configuration",
+		importWithBucketOwner: true,
+	},
+	"server_side_encryption_configuration": {
+		attributes:            []string{"server_side_encryption_configuration"},
+		resourceType:          "aws_s3_bucket_server_side_encryption_configuration",
+		importWithBucketOwner: true,
+	},
+	"versioning": {
+		attributes:            []string{"versioning"},
+		resourceType:          "aws_s3_bucket_versioning",
+		importWithBucketOwner: true,
+	},
+	"website": {
+		attributes:            []string{"website", "website_domain", "website_endpoint"},
+		resourceType:          "aws_s3_bucket_website_configuration",
+		importWithBucketOwner: true,
+	},
+}
+
+// BucketStandaloneConfiguration_Values returns the configurations that can be
+// declared in the aws_s3_bucket standalone_configurations argument.
+func BucketStandaloneConfiguration_Values() []string {
+	var values []string
+
+	for name, v := range bucketInlineConfigurations {
+		if len(v.attributes) > 0 {
+			values = append(values, name)
+		}
+	}
+
+	sort.Strings(values)
+
+	return values
+}
+
+// bucketInlineConfigurationState returns the current values of the inline
+// configurations that are not declared as managed by standalone resources.
+// It returns nil when there is no prior state to compare with, e.g. on import.
+func bucketInlineConfigurationState(d *schema.ResourceData) map[string]interface{} {
+	if d.Get("bucket").(string) == "" {
+		return nil
+	}
+
+	standalone := d.Get("standalone_configurations").(*schema.Set)
+	state := make(map[string]interface{})
+
+	for name, v := range bucketInlineConfigurations {
+		if standalone.Contains(name) {
+			continue
+		}
+
+		for _, attribute := range v.attributes {
+			state[attribute] = normalizeBucketInlineConfigurationValue(d.Get(attribute))
+		}
+	}
+
+	return state
+}
+
+// clearBucketStandaloneConfigurations empties the attributes of the inline
+// configurations declared as managed by standalone resources, so that changes
+// made by those resources are not reported as drift of the bucket.
+func clearBucketStandaloneConfigurations(d *schema.ResourceData) error {
+	for _, v := range d.Get("standalone_configurations").(*schema.Set).List() {
+		for _, attribute := range bucketInlineConfigurations[v.(string)].attributes {
+			if err := d.Set(attribute, nil); err != nil {
+				return fmt.Errorf("error clearing %s: %w", attribute, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// bucketInlineConfigurationDrift returns a warning for each inline
+// configuration whose value changed since the prior state. As these
+// attributes are read-only, such a change means that the configuration is
+// managed outside of the aws_s3_bucket resource.
+func bucketInlineConfigurationDrift(d *schema.ResourceData, prior map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if prior == nil {
+		return diags
+	}
+
+	var names []string
+	for name := range bucketInlineConfigurations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := bucketInlineConfigurations[name]
+
+		for _, attribute := range v.attributes {
+			old, ok := prior[attribute]
+
+			if !ok || reflect.DeepEqual(old, normalizeBucketInlineConfigurationValue(d.Get(attribute))) {
+				continue
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("S3 Bucket (%s) %s changed outside of aws_s3_bucket", d.Id(), name),
+				Detail: fmt.Sprintf("If the configuration is managed by an %[1]s resource, add %[2]q to the standalone_configurations argument "+
+					"of the aws_s3_bucket resource to stop reporting these changes.", v.resourceType, name),
+			})
+
+			break
+		}
+	}
+
+	return diags
+}
+
+// normalizeBucketInlineConfigurationValue replaces sets with their ordered
+// lists so that values can be compared with reflect.DeepEqual.
+func normalizeBucketInlineConfigurationValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return normalizeBucketInlineConfigurationValue(v.List())
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = normalizeBucketInlineConfigurationValue(e)
+		}
+		return l
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = normalizeBucketInlineConfigurationValue(e)
+		}
+		return m
+	default:
+		return v
+	}
+}
+
+// FindBucketInlineConfigurations returns the names of the configurations set on
+// the bucket that are managed by standalone resources.
+func FindBucketInlineConfigurations(conn *s3.S3, bucket, expectedBucketOwner string) ([]string, error) {
+	var owner *string
+	if expectedBucketOwner != "" {
+		owner = aws.String(expectedBucketOwner)
+	}
+
+	var names []string
+
+	accelerate, err := conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeMethodNotAllowed, ErrCodeUnsupportedArgument, ErrCodeNotImplemented) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) accelerate configuration: %w", bucket, err)
+	}
+
+	if err == nil && aws.StringValue(accelerate.Status) != "" {
+		names = append(names, "acceleration_status")
+	}
+
+	acl, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	if !bucketACLIsDefault(acl) {
+		names = append(names, "acl")
+	}
+
+	_, err = conn.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) CORS configuration: %w", bucket, err)
+	}
+
+	if err == nil {
+		names = append(names, "cors_rule")
+	}
+
+	_, err = conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	if err == nil {
+		names = append(names, "lifecycle_rule")
+	}
+
+	logging, err := conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNotImplemented) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) logging: %w", bucket, err)
+	}
+
+	if err == nil && logging.LoggingEnabled != nil {
+		names = append(names, "logging")
+	}
+
+	objectLock, err := conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeMethodNotAllowed, ErrCodeNotImplemented, ErrCodeObjectLockConfigurationNotFound) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) Object Lock configuration: %w", bucket, err)
+	}
+
+	if err == nil && objectLock.ObjectLockConfiguration != nil && objectLock.ObjectLockConfiguration.Rule != nil {
+		names = append(names, "object_lock_configuration")
+	}
+
+	_, err = conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucketPolicy, ErrCodeNotImplemented) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) policy: %w", bucket, err)
+	}
+
+	if err == nil {
+		names = append(names, "policy")
+	}
+
+	_, err = conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) replication: %w", bucket, err)
+	}
+
+	if err == nil {
+		names = append(names, "replication_configuration")
+	}
+
+	payer, err := conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNotImplemented) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) request payment: %w", bucket, err)
+	}
+
+	if err == nil && aws.StringValue(payer.Payer) == s3.PayerRequester {
+		names = append(names, "request_payer")
+	}
+
+	_, err = conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrMessageContains(err, ErrCodeServerSideEncryptionConfigurationNotFound, "encryption configuration was not found") {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) encryption: %w", bucket, err)
+	}
+
+	if err == nil {
+		names = append(names, "server_side_encryption_configuration")
+	}
+
+	versioning, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) versioning: %w", bucket, err)
+	}
+
+	if aws.StringValue(versioning.Status) != "" {
+		names = append(names, "versioning")
+	}
+
+	_, err = conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeMethodNotAllowed, ErrCodeNotImplemented, ErrCodeNoSuchWebsiteConfiguration, ErrCodeXNotImplemented) {
+		return nil, fmt.Errorf("error getting S3 Bucket (%s) website configuration: %w", bucket, err)
+	}
+
+	if err == nil {
+		names = append(names, "website")
+	}
+
+	return names, nil
+}
+
+// bucketACLIsDefault returns whether the bucket ACL holds only the owner's
+// FULL_CONTROL grant that S3 attaches to every bucket.
+func bucketACLIsDefault(acl *s3.GetBucketAclOutput) bool {
+	if acl == nil || acl.Owner == nil || len(acl.Grants) != 1 {
+		return false
+	}
+
+	grant := acl.Grants[0]
+
+	if grant.Grantee == nil || aws.StringValue(grant.Grantee.Type) != s3.TypeCanonicalUser {
+		return false
+	}
+
+	return aws.StringValue(grant.Grantee.ID) == aws.StringValue(acl.Owner.ID) && aws.StringValue(grant.Permission) == s3.PermissionFullControl
+}
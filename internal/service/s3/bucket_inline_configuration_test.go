@@ -0,0 +1,199 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBucketInlineConfigurationDrift(t *testing.T) {
+	testCases := []struct {
+		name          string
+		raw           map[string]interface{}
+		versioning    []interface{}
+		expectedDiags int
+		expectCleared bool
+	}{
+		{
+			name: "no change",
+			raw: map[string]interface{}{
+				"bucket": "test",
+			},
+			expectedDiags: 0,
+		},
+		{
+			name: "undeclared change",
+			raw: map[string]interface{}{
+				"bucket": "test",
+			},
+			versioning: []interface{}{
+				map[string]interface{}{
+					"enabled":    true,
+					"mfa_delete": false,
+				},
+			},
+			expectedDiags: 1,
+		},
+		{
+			name: "declared change",
+			raw: map[string]interface{}{
+				"bucket":                    "test",
+				"standalone_configurations": []interface{}{"versioning"},
+			},
+			versioning: []interface{}{
+				map[string]interface{}{
+					"enabled":    true,
+					"mfa_delete": false,
+				},
+			},
+			expectedDiags: 0,
+			expectCleared: true,
+		},
+		{
+			name: "import",
+			raw:  map[string]interface{}{},
+			versioning: []interface{}{
+				map[string]interface{}{
+					"enabled":    true,
+					"mfa_delete": false,
+				},
+			},
+			expectedDiags: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceBucket().Schema, testCase.raw)
+			d.SetId("test")
+
+			prior := bucketInlineConfigurationState(d)
+
+			if testCase.versioning != nil {
+				if err := d.Set("versioning", testCase.versioning); err != nil {
+					t.Fatalf("error setting versioning: %s", err)
+				}
+			}
+
+			if err := clearBucketStandaloneConfigurations(d); err != nil {
+				t.Fatalf("error clearing standalone configurations: %s", err)
+			}
+
+			diags := bucketInlineConfigurationDrift(d, prior)
+
+			if got := len(diags); got != testCase.expectedDiags {
+				t.Fatalf("got %d diagnostics, expected %d: %v", got, testCase.expectedDiags, diags)
+			}
+
+			for _, v := range diags {
+				if v.Severity != diag.Warning {
+					t.Errorf("got severity %v, expected warning", v.Severity)
+				}
+			}
+
+			if cleared := len(d.Get("versioning").([]interface{})) == 0; testCase.expectCleared && !cleared {
+				t.Errorf("expected versioning to be cleared")
+			}
+		})
+	}
+}
+
+func TestBucketResourceName(t *testing.T) {
+	testCases := []struct {
+		bucket   string
+		expected string
+	}{
+		{
+			bucket:   "my-bucket",
+			expected: "my-bucket",
+		},
+		{
+			bucket:   "logs.example.com",
+			expected: "logs_example_com",
+		},
+		{
+			bucket:   "123-data",
+			expected: "bucket_123-data",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.bucket, func(t *testing.T) {
+			if got := bucketResourceName(testCase.bucket); got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestBucketACLIsDefault(t *testing.T) {
+	ownerGrant := &s3.Grant{
+		Grantee: &s3.Grantee{
+			ID:   aws.String("owner"),
+			Type: aws.String(s3.TypeCanonicalUser),
+		},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+
+	testCases := []struct {
+		name     string
+		acl      *s3.GetBucketAclOutput
+		expected bool
+	}{
+		{
+			name: "owner full control",
+			acl: &s3.GetBucketAclOutput{
+				Grants: []*s3.Grant{ownerGrant},
+				Owner:  &s3.Owner{ID: aws.String("owner")},
+			},
+			expected: true,
+		},
+		{
+			name: "other canonical user",
+			acl: &s3.GetBucketAclOutput{
+				Grants: []*s3.Grant{ownerGrant},
+				Owner:  &s3.Owner{ID: aws.String("other")},
+			},
+			expected: false,
+		},
+		{
+			name: "owner read",
+			acl: &s3.GetBucketAclOutput{
+				Grants: []*s3.Grant{{
+					Grantee:    ownerGrant.Grantee,
+					Permission: aws.String(s3.PermissionRead),
+				}},
+				Owner: &s3.Owner{ID: aws.String("owner")},
+			},
+			expected: false,
+		},
+		{
+			name: "additional group grant",
+			acl: &s3.GetBucketAclOutput{
+				Grants: []*s3.Grant{
+					ownerGrant,
+					{
+						Grantee: &s3.Grantee{
+							Type: aws.String(s3.TypeGroup),
+							URI:  aws.String("http://acs.amazonaws.com/groups/global/AllUsers"),
+						},
+						Permission: aws.String(s3.PermissionRead),
+					},
+				},
+				Owner: &s3.Owner{ID: aws.String("owner")},
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := bucketACLIsDefault(testCase.acl); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
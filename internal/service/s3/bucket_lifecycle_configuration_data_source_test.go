@@ -0,0 +1,76 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketLifecycleConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.expiration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.expiration.0.days", "365"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.transition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rule.0.transition.*", map[string]string{
+						"days":          "30",
+						"storage_class": s3.TransitionStorageClassStandardIa,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketLifecycleConfigurationDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 365
+    }
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+
+data "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket_lifecycle_configuration.test.bucket
+}
+`, rName)
+}
@@ -0,0 +1,65 @@
+package s3
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceBucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBucketPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("expected_bucket_owner"); ok {
+		input.ExpectedBucketOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.GetBucketPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(output.Policy))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is an invalid JSON: %w", aws.StringValue(output.Policy), err)
+	}
+
+	d.SetId(bucket)
+	d.Set("policy", policy)
+
+	return nil
+}
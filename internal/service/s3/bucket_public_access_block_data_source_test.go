@@ -0,0 +1,56 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketPublicAccessBlockDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_public_access_block.test"
+	resourceName := "aws_s3_bucket_public_access_block.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPublicAccessBlockDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "block_public_policy", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_public_acls", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "restrict_public_buckets", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketPublicAccessBlockDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = false
+  restrict_public_buckets = true
+}
+
+data "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket_public_access_block.test.bucket
+}
+`, rName)
+}
@@ -0,0 +1,51 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketReplicationConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_replication_configuration.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	dstBucketResourceName := "aws_s3_bucket.destination"
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccBucketReplicationConfigurationBasic(rName, s3.StorageClassStandard),
+					`
+data "aws_s3_bucket_replication_configuration" "test" {
+  bucket = aws_s3_bucket_replication_configuration.test.bucket
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", "aws_s3_bucket.source", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.id", "foobar"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.prefix", "foo"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.status", s3.ReplicationRuleStatusEnabled),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.destination.0.storage_class", s3.StorageClassStandard),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.destination.0.bucket", dstBucketResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
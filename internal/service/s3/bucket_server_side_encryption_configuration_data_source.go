@@ -0,0 +1,88 @@
+package s3
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceBucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBucketServerSideEncryptionConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_server_side_encryption_by_default": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_master_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"sse_algorithm": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"bucket_key_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("expected_bucket_owner"); ok {
+		input.ExpectedBucketOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.GetBucketEncryption(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) server-side encryption configuration: %w", bucket, err)
+	}
+
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) server-side encryption configuration: empty output", bucket)
+	}
+
+	d.SetId(bucket)
+	if err := d.Set("rule", flattenBucketServerSideEncryptionConfigurationRules(output.ServerSideEncryptionConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
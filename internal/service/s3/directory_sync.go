@@ -0,0 +1,800 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncDefaultContentType = "application/octet-stream"
+	directorySyncDefaultParallelism = 10
+)
+
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  directorySyncDefaultContentType,
+			},
+			"delete_removed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"file_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultParallelism,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	// Set the ID first so that objects uploaded before a failure are recorded in state.
+	d.SetId(DirectorySyncCreateResourceID(bucket, keyPrefix))
+
+	if err := directorySync(ctx, d, meta, false); err != nil {
+		return diag.FromErr(fmt.Errorf("error syncing directory to S3 Bucket (%s): %w", bucket, err))
+	}
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	_, err := conn.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Directory Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading S3 Bucket (%s): %w", bucket, err))
+	}
+
+	remote, err := directorySyncRemoteObjects(ctx, conn, bucket, directorySyncKeyPrefix(d))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing S3 Bucket (%s) objects: %w", bucket, err))
+	}
+
+	// Any managed object that was removed from the bucket outside of Terraform is forgotten and forces a re-sync.
+	keys := directorySyncObjectKeys(d)
+	missing := false
+
+	for k := range keys {
+		if _, ok := remote[k]; !ok {
+			log.Printf("[DEBUG] S3 Directory Sync (%s): object (%s) not found", d.Id(), k)
+			delete(keys, k)
+			missing = true
+		}
+	}
+
+	if missing {
+		d.Set("manifest_hash", "")
+	}
+
+	if err := d.Set("object_keys", directorySyncFlattenObjectKeys(keys)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting object_keys: %w", err))
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Changes to object properties that are not reflected in the object content require every object to be uploaded.
+	force := d.HasChanges("acl", "cache_control", "content_types", "default_content_type")
+
+	if err := directorySync(ctx, d, meta, force); err != nil {
+		return diag.FromErr(fmt.Errorf("error syncing directory to S3 Bucket (%s): %w", d.Get("bucket").(string), err))
+	}
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	// Only the objects uploaded by this resource are deleted, including objects whose local files have since been removed.
+	keys := directorySyncFlattenObjectKeys(directorySyncObjectKeys(d))
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s) objects: %d", d.Id(), len(keys))
+	err := directorySyncDeleteObjects(ctx, conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting S3 Directory Sync (%s) objects: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"content_types", "default_content_type", "exclude", "include", "key_prefix", "source_dir"} {
+		if !d.NewValueKnown(k) {
+			if err := d.SetNewComputed("manifest_hash"); err != nil {
+				return err
+			}
+
+			if err := d.SetNewComputed("object_keys"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("file_count")
+		}
+	}
+
+	files, err := directorySyncLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := directorySyncManifestHash(files); d.Get("manifest_hash").(string) != hash {
+		if err := d.SetNew("manifest_hash", hash); err != nil {
+			return err
+		}
+
+		if err := d.SetNewComputed("object_keys"); err != nil {
+			return err
+		}
+	} else if d.Id() != "" && d.HasChange("delete_removed") {
+		if err := d.SetNewComputed("object_keys"); err != nil {
+			return err
+		}
+	}
+
+	if n := len(files); d.Get("file_count").(int) != n {
+		if err := d.SetNew("file_count", n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// directorySync uploads new and changed files to the bucket and, if configured, deletes the managed objects
+// whose local files were removed. Objects uploaded by this resource are recorded in object_keys; other objects
+// under the key prefix are never deleted.
+// If force is true all files are uploaded.
+func directorySync(ctx context.Context, d *schema.ResourceData, meta interface{}, force bool) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	parallelism := d.Get("parallelism").(int)
+
+	files, err := directorySyncLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := directorySyncRemoteObjects(ctx, conn, bucket, directorySyncKeyPrefix(d))
+
+	if err != nil {
+		return fmt.Errorf("error listing objects: %w", err)
+	}
+
+	uploads := files
+
+	if !force {
+		uploads, err = directorySyncChangedFiles(ctx, conn, bucket, files, remote, parallelism)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	input := &s3manager.UploadInput{
+		ACL:    aws.String(d.Get("acl").(string)),
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	keys := directorySyncObjectKeys(d)
+
+	// Unchanged objects were uploaded by this resource or are identical to the local files.
+	for _, file := range files {
+		keys[file.key] = struct{}{}
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Directory Sync objects: %d of %d", len(uploads), len(files))
+	err = directorySyncUploadFiles(ctx, conn, input, uploads, parallelism)
+
+	// Record the managed objects, even on failure, so that they are deleted on destroy.
+	d.Set("object_keys", directorySyncFlattenObjectKeys(keys))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("delete_removed").(bool) {
+		local := make(map[string]struct{}, len(files))
+
+		for _, file := range files {
+			local[file.key] = struct{}{}
+		}
+
+		var deletes []string
+
+		for k := range keys {
+			if _, ok := local[k]; !ok {
+				deletes = append(deletes, k)
+			}
+		}
+
+		sort.Strings(deletes)
+
+		log.Printf("[DEBUG] Deleting removed S3 Directory Sync objects: %d", len(deletes))
+		if err := directorySyncDeleteObjects(ctx, conn, bucket, deletes); err != nil {
+			return fmt.Errorf("error deleting removed objects: %w", err)
+		}
+
+		d.Set("object_keys", directorySyncFlattenObjectKeys(local))
+	}
+
+	d.Set("file_count", len(files))
+	d.Set("manifest_hash", directorySyncManifestHash(files))
+
+	return nil
+}
+
+type directorySyncFile struct {
+	contentType string
+	key         string
+	md5         string
+	path        string
+}
+
+type directorySyncResourceData interface {
+	Get(string) interface{}
+}
+
+// directorySyncObjectKeys returns the keys of the objects managed by this resource.
+func directorySyncObjectKeys(d directorySyncResourceData) map[string]struct{} {
+	keys := make(map[string]struct{})
+
+	for _, v := range d.Get("object_keys").(*schema.Set).List() {
+		keys[v.(string)] = struct{}{}
+	}
+
+	return keys
+}
+
+func directorySyncFlattenObjectKeys(keys map[string]struct{}) []string {
+	output := make([]string, 0, len(keys))
+
+	for k := range keys {
+		output = append(output, k)
+	}
+
+	sort.Strings(output)
+
+	return output
+}
+
+// directorySyncKeyPrefix returns the configured key prefix, ending in "/" unless empty.
+func directorySyncKeyPrefix(d directorySyncResourceData) string {
+	keyPrefix := d.Get("key_prefix").(string)
+
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+
+	return keyPrefix
+}
+
+// directorySyncLocalFiles returns the files in the source directory matching the include and exclude patterns.
+// The returned files are sorted by key.
+func directorySyncLocalFiles(d directorySyncResourceData) ([]directorySyncFile, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	keyPrefix := directorySyncKeyPrefix(d)
+	includes := aws.StringValueSlice(flex.ExpandStringSet(d.Get("include").(*schema.Set)))
+	excludes := aws.StringValueSlice(flex.ExpandStringSet(d.Get("exclude").(*schema.Set)))
+	contentTypes := make(map[string]string)
+
+	for k, v := range d.Get("content_types").(map[string]interface{}) {
+		if !strings.HasPrefix(k, ".") {
+			k = "." + k
+		}
+
+		contentTypes[strings.ToLower(k)] = v.(string)
+	}
+
+	defaultContentType := d.Get("default_content_type").(string)
+
+	var files []directorySyncFile
+
+	err = filepath.WalkDir(sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(includes) > 0 && !directorySyncMatchAny(includes, rel) {
+			return nil
+		}
+
+		if directorySyncMatchAny(excludes, rel) {
+			return nil
+		}
+
+		sum, err := directorySyncFileMD5(p)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, directorySyncFile{
+			contentType: directorySyncContentType(rel, contentTypes, defaultContentType),
+			key:         keyPrefix + rel,
+			md5:         sum,
+			path:        p,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+
+	return files, nil
+}
+
+// directorySyncMatchAny returns whether the slash-separated name matches any of the glob patterns.
+func directorySyncMatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if directorySyncMatch(strings.Split(pattern, "/"), strings.Split(name, "/")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directorySyncMatch matches path segments against pattern segments.
+// A "**" pattern segment matches zero or more path segments, other segments are matched using path.Match.
+func directorySyncMatch(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(names); i++ {
+				if directorySyncMatch(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(patterns[0], names[0]); err != nil || !ok {
+			return false
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0
+}
+
+func directorySyncContentType(name string, overrides map[string]string, defaultContentType string) string {
+	ext := strings.ToLower(path.Ext(name))
+
+	if v, ok := overrides[ext]; ok {
+		return v
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v
+	}
+
+	return defaultContentType
+}
+
+func directorySyncFileMD5(name string) (string, error) {
+	file, err := os.Open(name)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	hash := md5.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// directorySyncManifestHash returns a hash of the keys, contents and content types of the specified files.
+func directorySyncManifestHash(files []directorySyncFile) string {
+	hash := sha256.New()
+
+	for _, file := range files {
+		fmt.Fprintf(hash, "%s\t%s\t%s\n", file.key, file.md5, file.contentType)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// directorySyncRemoteObjects returns a map of key to ETag for all objects in the bucket under the key prefix.
+func directorySyncRemoteObjects(ctx context.Context, conn *s3.S3, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	output := make(map[string]string)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			if v == nil {
+				continue
+			}
+
+			output[aws.StringValue(v.Key)] = strings.Trim(aws.StringValue(v.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// directorySyncChangedFiles returns the files whose content differs from the corresponding object in the bucket.
+// An object whose ETag is the MD5 checksum of the file is unchanged. Otherwise, e.g. for multipart uploads
+// or KMS-encrypted objects, the object's SHA-256 checksum, recorded when it was uploaded, is compared.
+func directorySyncChangedFiles(ctx context.Context, conn *s3.S3, bucket string, files []directorySyncFile, remote map[string]string, parallelism int) ([]directorySyncFile, error) {
+	var changed, unknown []directorySyncFile
+
+	for _, file := range files {
+		etag, ok := remote[file.key]
+
+		switch {
+		case !ok:
+			changed = append(changed, file)
+		case etag != file.md5:
+			unknown = append(unknown, file)
+		}
+	}
+
+	var mu sync.Mutex
+
+	err := directorySyncParallel(unknown, parallelism, func(file directorySyncFile) error {
+		ok, err := directorySyncObjectChecksumEquals(ctx, conn, bucket, file)
+
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			mu.Lock()
+			changed = append(changed, file)
+			mu.Unlock()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(changed, func(i, j int) bool {
+		return changed[i].key < changed[j].key
+	})
+
+	return changed, nil
+}
+
+func directorySyncObjectChecksumEquals(ctx context.Context, conn *s3.S3, bucket string, file directorySyncFile) (bool, error) {
+	output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		ChecksumMode: aws.String(s3.ChecksumModeEnabled),
+		Key:          aws.String(file.key),
+	})
+
+	if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error reading object (%s): %w", file.key, err)
+	}
+
+	remote := aws.StringValue(output.ChecksumSHA256)
+
+	if remote == "" {
+		return false, nil
+	}
+
+	body, err := os.Open(file.path)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer body.Close()
+
+	local, err := objectChecksum(body, s3.ChecksumAlgorithmSha256)
+
+	if err != nil {
+		return false, fmt.Errorf("error calculating file (%s) checksum: %w", file.path, err)
+	}
+
+	return local == remote, nil
+}
+
+// directorySyncUploadFiles uploads the specified files with SHA-256 checksums.
+func directorySyncUploadFiles(ctx context.Context, conn *s3.S3, template *s3manager.UploadInput, files []directorySyncFile, parallelism int) error {
+	uploader := s3manager.NewUploaderWithClient(conn)
+
+	return directorySyncParallel(files, parallelism, func(file directorySyncFile) error {
+		body, err := os.Open(file.path)
+
+		if err != nil {
+			return fmt.Errorf("error uploading object (%s): %w", file.key, err)
+		}
+
+		defer body.Close()
+
+		input := *template
+		input.Body = body
+		input.ChecksumAlgorithm = aws.String(s3.ChecksumAlgorithmSha256)
+		input.ContentType = aws.String(file.contentType)
+		input.Key = aws.String(file.key)
+
+		size, err := aws.SeekerLen(body)
+
+		if err != nil {
+			return fmt.Errorf("error uploading object (%s): %w", file.key, err)
+		}
+
+		// Pre-computed checksums are ignored by the upload manager for multipart uploads.
+		if partSize := objectChecksumPartSize(size); size > partSize {
+			err = uploadObjectPartsWithContext(ctx, conn, &input, s3.ChecksumAlgorithmSha256, partSize)
+		} else {
+			var checksum string
+			checksum, err = objectPartChecksum(body, s3.ChecksumAlgorithmSha256)
+
+			if err == nil {
+				setUploadInputChecksum(&input, s3.ChecksumAlgorithmSha256, checksum)
+				_, err = uploader.UploadWithContext(ctx, &input)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("error uploading object (%s): %w", file.key, err)
+		}
+
+		return nil
+	})
+}
+
+// directorySyncParallel calls f for each of the specified files using up to parallelism goroutines.
+func directorySyncParallel(files []directorySyncFile, parallelism int, f func(directorySyncFile) error) error {
+	ch := make(chan directorySyncFile)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs *multierror.Error
+
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for file := range ch {
+				if err := f(file); err != nil {
+					mu.Lock()
+					errs = multierror.Append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, file := range files {
+		ch <- file
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+// directorySyncDeleteObjectsBatchSize is the maximum number of keys that can be deleted in a single DeleteObjects call.
+const directorySyncDeleteObjectsBatchSize = 1000
+
+func directorySyncDeleteObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) error {
+	var errs *multierror.Error
+
+	for len(keys) > 0 {
+		n := directorySyncDeleteObjectsBatchSize
+
+		if len(keys) < n {
+			n = len(keys)
+		}
+
+		var objects []*s3.ObjectIdentifier
+
+		for _, k := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(k)})
+		}
+
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting object (%s): %s: %s", aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func DirectorySyncCreateResourceID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return bucket + "/" + keyPrefix
+}
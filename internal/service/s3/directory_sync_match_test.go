@@ -0,0 +1,107 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestDirectorySyncMatchAny(t *testing.T) {
+	testCases := []struct {
+		name     string
+		patterns []string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "no patterns",
+			patterns: nil,
+			path:     "index.html",
+			expected: false,
+		},
+		{
+			name:     "exact",
+			patterns: []string{"index.html"},
+			path:     "index.html",
+			expected: true,
+		},
+		{
+			name:     "star in top-level directory",
+			patterns: []string{"*.html"},
+			path:     "index.html",
+			expected: true,
+		},
+		{
+			name:     "star does not cross directories",
+			patterns: []string{"*.html"},
+			path:     "docs/index.html",
+			expected: false,
+		},
+		{
+			name:     "double star matches zero directories",
+			patterns: []string{"**/*.html"},
+			path:     "index.html",
+			expected: true,
+		},
+		{
+			name:     "double star matches nested directories",
+			patterns: []string{"**/*.html"},
+			path:     "docs/guide/index.html",
+			expected: true,
+		},
+		{
+			name:     "trailing double star",
+			patterns: []string{"drafts/**"},
+			path:     "drafts/2022/post.md",
+			expected: true,
+		},
+		{
+			name:     "trailing double star other directory",
+			patterns: []string{"drafts/**"},
+			path:     "posts/2022/post.md",
+			expected: false,
+		},
+		{
+			name:     "double star in middle",
+			patterns: []string{"assets/**/*.css"},
+			path:     "assets/css/site.css",
+			expected: true,
+		},
+		{
+			name:     "double star in middle wrong extension",
+			patterns: []string{"assets/**/*.css"},
+			path:     "assets/css/site.js",
+			expected: false,
+		},
+		{
+			name:     "pattern longer than path",
+			patterns: []string{"docs/*.html"},
+			path:     "docs",
+			expected: false,
+		},
+		{
+			name:     "path longer than pattern",
+			patterns: []string{"docs"},
+			path:     "docs/index.html",
+			expected: false,
+		},
+		{
+			name:     "malformed pattern",
+			patterns: []string{"[.html"},
+			path:     "[.html",
+			expected: false,
+		},
+		{
+			name:     "any pattern",
+			patterns: []string{"*.css", "**/.DS_Store"},
+			path:     "images/.DS_Store",
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := directorySyncMatchAny(testCase.patterns, testCase.path); got != testCase.expected {
+				t.Errorf("directorySyncMatchAny(%q, %q) = %t, expected %t", testCase.patterns, testCase.path, got, testCase.expected)
+			}
+		})
+	}
+}
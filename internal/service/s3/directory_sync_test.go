@@ -0,0 +1,449 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"img/logo.data": "logo",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acl", s3.ObjectCannedACLPrivate),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/img/logo.data", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"old.html":   "<html>old</html>",
+	})
+	var manifestHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncDeleteRemovedConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					testAccCheckDirectorySyncManifestHash(resourceName, &manifestHash),
+					testAccCheckDirectorySyncObjectExists(resourceName, "site/old.html"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, sourceDir, map[string]string{
+						"index.html": "<html>updated</html>",
+						"new.html":   "<html>new</html>",
+					})
+
+					if err := os.Remove(filepath.Join(sourceDir, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncDeleteRemovedConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					testAccCheckDirectorySyncManifestHashChanged(resourceName, &manifestHash),
+					testAccCheckDirectorySyncObjectExists(resourceName, "site/new.html"),
+					testAccCheckDirectorySyncObjectNotExists(resourceName, "site/old.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_destroyRemoved(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"old.html":   "<html>old</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "object_keys.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(sourceDir, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "1"),
+					// The object is kept, and still managed, as delete_removed is not set.
+					resource.TestCheckResourceAttr(resourceName, "object_keys.#", "2"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "site/old.html"),
+				),
+			},
+			{
+				// Destroying does not need the source directory.
+				PreConfig: func() {
+					if err := os.RemoveAll(sourceDir); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncBaseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectNotExists(bucketResourceName, "site/index.html"),
+					testAccCheckDirectorySyncObjectNotExists(bucketResourceName, "site/old.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteRemovedUnmanaged(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"old.html":   "<html>old</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncUnmanagedConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "object_keys.*", "site/index.html"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "object_keys.*", "site/old.html"),
+					testAccCheckDirectorySyncObjectExists(bucketResourceName, "site/unmanaged.txt"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(sourceDir, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncUnmanagedConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object_keys.#", "1"),
+					testAccCheckDirectorySyncObjectNotExists(bucketResourceName, "site/old.html"),
+					// Objects not uploaded by the resource are not deleted.
+					testAccCheckDirectorySyncObjectExists(bucketResourceName, "site/unmanaged.txt"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncUnmanagedObjectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectNotExists(bucketResourceName, "site/index.html"),
+					testAccCheckDirectorySyncObjectExists(bucketResourceName, "site/unmanaged.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":         "<html></html>",
+		"docs/a.html":        "<html>a</html>",
+		"docs/drafts/b.html": "<html>b</html>",
+		"README.md":          "readme",
+		"data.json":          "{}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncIncludeExcludeConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "3"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "site/index.html"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "site/docs/a.html"),
+					testAccCheckDirectorySyncObjectNotExists(resourceName, "site/docs/drafts/b.html"),
+					testAccCheckDirectorySyncObjectNotExists(resourceName, "site/README.md"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/data.json", "application/x-custom-json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_sync" {
+			continue
+		}
+
+		output, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Contents) > 0 {
+			return fmt.Errorf("S3 Directory Sync %s objects still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectorySyncObjectExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Object (%s): %w", key, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 Object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Object (%s): %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type = %q, want %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncManifestHash(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes["manifest_hash"]
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncManifestHashChanged(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["manifest_hash"] == *v {
+			return fmt.Errorf("S3 Directory Sync (%s) manifest hash not changed", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for name, data := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncDeleteRemovedConfig(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source_dir     = %[1]q
+  delete_removed = true
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncUnmanagedObjectConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), `
+resource "aws_s3_object" "unmanaged" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "site/unmanaged.txt"
+  content = "unmanaged"
+}
+`)
+}
+
+func testAccDirectorySyncUnmanagedConfig(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncUnmanagedObjectConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site"
+  source_dir     = %[1]q
+  delete_removed = true
+
+  depends_on = [aws_s3_object.unmanaged]
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncIncludeExcludeConfig(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+
+  include = ["**/*.html", "*.json"]
+  exclude = ["**/drafts/**"]
+
+  content_types = {
+    ".json" = "application/x-custom-json"
+  }
+}
+`, sourceDir))
+}
@@ -3,8 +3,12 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"net/http"
@@ -14,6 +18,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -70,6 +75,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -203,6 +229,12 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 		Key:    aws.String(key),
 	}
 
+	// Retrieving checksums of KMS encrypted objects requires kms:Decrypt permissions
+	// so only do so if checksums are configured.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(s3ObjectCreationTimeout, func() *resource.RetryError {
@@ -239,6 +271,10 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -420,7 +456,6 @@ func resourceObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.R
 
 func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
-	uploader := s3manager.NewUploaderWithClient(conn)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -532,6 +567,39 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 		input.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		algorithm := v.(string)
+		input.ChecksumAlgorithm = aws.String(algorithm)
+
+		size, err := aws.SeekerLen(body)
+
+		if err != nil {
+			return fmt.Errorf("error determining S3 object size: %w", err)
+		}
+
+		// Pre-computed checksums are ignored by the upload manager for multipart uploads
+		// so objects larger than a single part are uploaded part by part.
+		if partSize := objectChecksumPartSize(size); size > partSize {
+			if err := uploadObjectParts(conn, input, algorithm, partSize); err != nil {
+				return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
+			}
+
+			d.SetId(key)
+
+			return resourceObjectRead(d, meta)
+		}
+
+		checksum, err := objectPartChecksum(body, algorithm)
+
+		if err != nil {
+			return fmt.Errorf("error calculating S3 object %s checksum: %w", algorithm, err)
+		}
+
+		setUploadInputChecksum(input, algorithm, checksum)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(conn)
+
 	if _, err := uploader.Upload(input); err != nil {
 		return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
 	}
@@ -573,7 +641,13 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	if hasS3ObjectContentChanges(d) {
+	if hasObjectChecksumChanges(d) || hasS3ObjectContentChanges(d) {
+		for _, k := range objectChecksumAttributes {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -585,10 +659,52 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// hasObjectChecksumChanges returns whether the checksum of the configured object content
+// differs from the checksum of the object in S3.
+// Content that cannot be read, e.g. a source file created during apply, is treated as unknown
+// and not compared.
+func hasObjectChecksumChanges(d *schema.ResourceDiff) bool {
+	v, ok := d.GetOk("checksum_algorithm")
+
+	if !ok || d.HasChange("checksum_algorithm") || d.Id() == "" {
+		return false
+	}
+
+	for _, k := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(k) {
+			return false
+		}
+	}
+
+	algorithm := v.(string)
+	body, err := objectContent(d)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping S3 Object (%s) %s checksum comparison: %s", d.Id(), algorithm, err)
+		return false
+	}
+
+	defer body.Close()
+
+	checksum, err := objectChecksum(body, algorithm)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping S3 Object (%s) %s checksum comparison: %s", d.Id(), algorithm, err)
+		return false
+	}
+
+	return d.Get(objectChecksumAttribute(algorithm)).(string) != checksum
+}
+
 func hasS3ObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -611,6 +727,292 @@ func hasS3ObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+var objectChecksumAttributes = []string{
+	"checksum_crc32",
+	"checksum_crc32c",
+	"checksum_sha1",
+	"checksum_sha256",
+}
+
+// objectChecksumAttribute returns the name of the attribute holding the checksum for the specified algorithm.
+func objectChecksumAttribute(algorithm string) string {
+	return "checksum_" + strings.ToLower(algorithm)
+}
+
+// objectContent returns the configured object content.
+func objectContent(d *schema.ResourceDiff) (io.ReadSeekCloser, error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+		}
+
+		file, err := os.Open(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		content, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding content_base64: %w", err)
+		}
+
+		return nopReadSeekCloser{bytes.NewReader(content)}, nil
+	}
+
+	return nopReadSeekCloser{strings.NewReader(d.Get("content").(string))}, nil
+}
+
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error {
+	return nil
+}
+
+// objectChecksumPartSize returns the part size used to upload an object of the specified size with an additional checksum.
+// The part size matches the upload manager's default so that objects are split the same way however they were uploaded.
+func objectChecksumPartSize(size int64) int64 {
+	partSize := s3manager.DefaultUploadPartSize
+
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = size/int64(s3manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+func newObjectChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectChecksum returns the checksum S3 reports for the specified content uploaded using the specified algorithm.
+// Content larger than a single part is uploaded in multiple parts and has a composite checksum,
+// the base64 encoded checksum of the concatenated part checksums suffixed with the number of parts.
+// The content is rewound after the checksum is calculated.
+func objectChecksum(r io.ReadSeeker, algorithm string) (string, error) {
+	size, err := aws.SeekerLen(r)
+
+	if err != nil {
+		return "", err
+	}
+
+	partSize := objectChecksumPartSize(size)
+
+	if size <= partSize {
+		return objectPartChecksum(r, algorithm)
+	}
+
+	composite, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := 0
+
+	for offset := int64(0); offset < size; offset += partSize {
+		h, err := newObjectChecksumHash(algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := io.CopyN(h, r, partSize); err != nil && err != io.EOF {
+			return "", err
+		}
+
+		composite.Write(h.Sum(nil))
+		parts++
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(composite.Sum(nil)), parts), nil
+}
+
+// objectPartChecksum returns the base64 encoded checksum of the specified content using the specified algorithm.
+// The content is rewound after the checksum is calculated.
+func objectPartChecksum(r io.ReadSeeker, algorithm string) (string, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadObjectParts uploads the object in parts of the specified size, each with an additional checksum
+// calculated using the specified algorithm. S3 then records the composite checksum of the parts.
+func uploadObjectParts(conn *s3.S3, input *s3manager.UploadInput, algorithm string, partSize int64) error {
+	return uploadObjectPartsWithContext(context.Background(), conn, input, algorithm, partSize)
+}
+
+func uploadObjectPartsWithContext(ctx context.Context, conn *s3.S3, input *s3manager.UploadInput, algorithm string, partSize int64) error {
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+
+	output, err := conn.CreateMultipartUploadWithContext(ctx, createInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating multipart upload: %w", err)
+	}
+
+	uploadID := output.UploadId
+
+	if err := uploadObjectPartsWithChecksum(ctx, conn, input, uploadID, algorithm, partSize); err != nil {
+		_, abortErr := conn.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   input.Bucket,
+			Key:      input.Key,
+			UploadId: uploadID,
+		})
+
+		if abortErr != nil {
+			log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", aws.StringValue(uploadID), abortErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func uploadObjectPartsWithChecksum(ctx context.Context, conn *s3.S3, input *s3manager.UploadInput, uploadID *string, algorithm string, partSize int64) error {
+	var parts []*s3.CompletedPart
+	buf := make([]byte, partSize)
+
+	for partNumber := int64(1); ; partNumber++ {
+		n, err := io.ReadFull(input.Body, buf)
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return fmt.Errorf("error reading part %d: %w", partNumber, err)
+		}
+
+		body := bytes.NewReader(buf[:n])
+		checksum, err := objectPartChecksum(body, algorithm)
+
+		if err != nil {
+			return fmt.Errorf("error calculating part %d %s checksum: %w", partNumber, algorithm, err)
+		}
+
+		partInput := &s3.UploadPartInput{
+			Body:              body,
+			Bucket:            input.Bucket,
+			ChecksumAlgorithm: aws.String(algorithm),
+			Key:               input.Key,
+			PartNumber:        aws.Int64(partNumber),
+			UploadId:          uploadID,
+		}
+
+		setUploadPartInputChecksum(partInput, algorithm, checksum)
+
+		output, err := conn.UploadPartWithContext(ctx, partInput)
+
+		if err != nil {
+			return fmt.Errorf("error uploading part %d: %w", partNumber, err)
+		}
+
+		parts = append(parts, &s3.CompletedPart{
+			ChecksumCRC32:  partInput.ChecksumCRC32,
+			ChecksumCRC32C: partInput.ChecksumCRC32C,
+			ChecksumSHA1:   partInput.ChecksumSHA1,
+			ChecksumSHA256: partInput.ChecksumSHA256,
+			ETag:           output.ETag,
+			PartNumber:     aws.Int64(partNumber),
+		})
+	}
+
+	_, err := conn.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          input.Bucket,
+		Key:             input.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+		UploadId:        uploadID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error completing multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+func setUploadInputChecksum(input *s3manager.UploadInput, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+// headObjectChecksum returns the checksum of the object for the specified algorithm.
+func headObjectChecksum(output *s3.HeadObjectOutput, algorithm string) string {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return aws.StringValue(output.ChecksumCRC32)
+	case s3.ChecksumAlgorithmCrc32c:
+		return aws.StringValue(output.ChecksumCRC32C)
+	case s3.ChecksumAlgorithmSha1:
+		return aws.StringValue(output.ChecksumSHA1)
+	case s3.ChecksumAlgorithmSha256:
+		return aws.StringValue(output.ChecksumSHA256)
+	}
+
+	return ""
+}
+
+func setUploadPartInputChecksum(input *s3.UploadPartInput, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
@@ -0,0 +1,99 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	partSize := int(s3manager.DefaultUploadPartSize)
+	multipart := bytes.Repeat([]byte("a"), partSize+1)
+	part1 := sha256.Sum256(multipart[:partSize])
+	part2 := sha256.Sum256(multipart[partSize:])
+	composite := sha256.Sum256(append(part1[:], part2[:]...))
+
+	testCases := []struct {
+		name      string
+		content   []byte
+		algorithm string
+		expected  string
+	}{
+		{
+			name:      "crc32c",
+			content:   []byte("checksum content"),
+			algorithm: s3.ChecksumAlgorithmCrc32c,
+			expected:  "YKwsKg==",
+		},
+		{
+			name:      "sha256",
+			content:   []byte("checksum content"),
+			algorithm: s3.ChecksumAlgorithmSha256,
+			expected:  "nv/y+81/+gPqBBdRZzctlwYpoup/wA77CIGd9Vf5LZc=",
+		},
+		{
+			name:      "single part",
+			content:   multipart[:partSize],
+			algorithm: s3.ChecksumAlgorithmSha256,
+			expected:  base64.StdEncoding.EncodeToString(part1[:]),
+		},
+		{
+			name:      "multipart",
+			content:   multipart,
+			algorithm: s3.ChecksumAlgorithmSha256,
+			expected:  fmt.Sprintf("%s-2", base64.StdEncoding.EncodeToString(composite[:])),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := bytes.NewReader(testCase.content)
+			got, err := objectChecksum(r, testCase.algorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("objectChecksum() = %q, expected %q", got, testCase.expected)
+			}
+
+			if offset, _ := r.Seek(0, io.SeekCurrent); offset != 0 {
+				t.Errorf("content not rewound, offset %d", offset)
+			}
+		})
+	}
+}
+
+func TestObjectChecksumPartSize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		size     int64
+		expected int64
+	}{
+		{
+			name:     "default",
+			size:     1024,
+			expected: s3manager.DefaultUploadPartSize,
+		},
+		{
+			name:     "maximum parts",
+			size:     s3manager.DefaultUploadPartSize * s3manager.MaxUploadParts,
+			expected: s3manager.DefaultUploadPartSize + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := objectChecksumPartSize(testCase.size); got != testCase.expected {
+				t.Errorf("objectChecksumPartSize(%d) = %d, expected %d", testCase.size, got, testCase.expected)
+			}
+		})
+	}
+}
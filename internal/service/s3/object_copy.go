@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -9,8 +10,10 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -50,6 +53,27 @@ func ResourceObjectCopy() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content_disposition": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -287,7 +311,10 @@ func ResourceObjectCopy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceObjectCopyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -303,11 +330,18 @@ func resourceObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	resp, err := conn.HeadObject(
-		&s3.HeadObjectInput{
-			Bucket: aws.String(bucket),
-			Key:    aws.String(key),
-		})
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	// Retrieving checksums of KMS encrypted objects requires kms:Decrypt permissions
+	// so only do so if checksums are configured.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
+	resp, err := conn.HeadObject(input)
 
 	if !d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
 		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
@@ -327,6 +361,10 @@ func resourceObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -410,6 +448,11 @@ func resourceObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
 		"bucket",
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_disposition",
 		"content_encoding",
 		"content_language",
@@ -494,6 +537,10 @@ func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
 		input.CacheControl = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("content_disposition"); ok {
 		input.ContentDisposition = aws.String(v.(string))
 	}
@@ -749,3 +796,97 @@ func expandS3Grants(tfList []interface{}) *s3Grants {
 
 	return apiObjects
 }
+
+func resourceObjectCopyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	if hasObjectCopyChecksumChanges(conn, d) {
+		for _, k := range objectChecksumAttributes {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+
+		return d.SetNewComputed("version_id")
+	}
+
+	return nil
+}
+
+// hasObjectCopyChecksumChanges returns whether the checksum of the source object
+// differs from the checksum of the copied object.
+// Source objects without a full object checksum for the configured algorithm, or that
+// cannot be read, are not compared as S3 calculates a new checksum when copying them.
+func hasObjectCopyChecksumChanges(conn *s3.S3, d *schema.ResourceDiff) bool {
+	v, ok := d.GetOk("checksum_algorithm")
+
+	if !ok || d.HasChange("checksum_algorithm") || d.Id() == "" || !d.NewValueKnown("source") {
+		return false
+	}
+
+	algorithm := v.(string)
+	source := d.Get("source").(string)
+	bucket, key, ok := parseObjectCopySource(source)
+
+	if !ok {
+		return false
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		ChecksumMode: aws.String(s3.ChecksumModeEnabled),
+		Key:          aws.String(key),
+	}
+
+	if v, ok := d.GetOk("expected_source_bucket_owner"); ok {
+		input.ExpectedBucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("request_payer"); ok {
+		input.RequestPayer = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_customer_algorithm"); ok {
+		input.SSECustomerAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_customer_key"); ok {
+		input.SSECustomerKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_customer_key_md5"); ok {
+		input.SSECustomerKeyMD5 = aws.String(v.(string))
+	}
+
+	output, err := conn.HeadObject(input)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping S3 Object Copy (%s) %s checksum comparison: error reading source S3 Object (%s): %s", d.Id(), algorithm, source, err)
+		return false
+	}
+
+	checksum := headObjectChecksum(output, algorithm)
+
+	// Composite checksums of multipart source objects are not preserved by the copy.
+	if checksum == "" || strings.Contains(checksum, "-") {
+		return false
+	}
+
+	return d.Get(objectChecksumAttribute(algorithm)).(string) != checksum
+}
+
+// parseObjectCopySource returns the bucket and key of a copy source in bucket/key format.
+// Access point ARNs are not supported.
+func parseObjectCopySource(source string) (string, string, bool) {
+	if arn.IsARN(source) {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
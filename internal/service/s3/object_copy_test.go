@@ -1,7 +1,10 @@
 package s3_test
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -41,6 +44,73 @@ func TestAccS3ObjectCopy_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectCopy_checksumAlgorithm(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	key := "HundBegraven"
+	sourceKey := "WshngtnNtnls"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, s3.ChecksumAlgorithmCrc32c),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmCrc32c),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "YKwsKg=="),
+				),
+			},
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmSha256),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "nv/y+81/+gPqBBdRZzctlwYpoup/wA77CIGd9Vf5LZc="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectCopy_checksumAlgorithmSourceChanged(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	sourceName := "aws_s3_object.source"
+	key := "HundBegraven"
+	sourceKey := "WshngtnNtnls"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithmSource(rName1, sourceKey, rName2, key, "checksum content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "nv/y+81/+gPqBBdRZzctlwYpoup/wA77CIGd9Vf5LZc="),
+					testAccCheckObjectCopyPutSource(sourceName, "updated checksum content"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithmSource(rName1, sourceKey, rName2, key, "updated checksum content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "+gE3fnNLHf6LLHAURRUJrkUFxMsRmTk+guzeJlHLqko="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectCopy_BucketKeyEnabled_bucket(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_object_copy.test"
@@ -130,6 +200,29 @@ func testAccCheckObjectCopyExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckObjectCopyPutSource replaces the copy source object outside of Terraform.
+func testAccCheckObjectCopyPutSource(n, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		checksum := sha256.Sum256([]byte(content))
+
+		_, err := conn.PutObject(&s3.PutObjectInput{
+			Body:              strings.NewReader(content),
+			Bucket:            aws.String(rs.Primary.Attributes["bucket"]),
+			ChecksumAlgorithm: aws.String(s3.ChecksumAlgorithmSha256),
+			ChecksumSHA256:    aws.String(base64.StdEncoding.EncodeToString(checksum[:])),
+			Key:               aws.String(rs.Primary.Attributes["key"]),
+		})
+
+		return err
+	}
+}
+
 func testAccObjectCopyConfig_basic(rName1, sourceKey, rName2, key string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "source" {
@@ -160,6 +253,57 @@ resource "aws_s3_object_copy" "test" {
 `, rName1, sourceKey, rName2, key)
 }
 
+func testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = %[2]q
+  content = "checksum content"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = %[3]q
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket             = aws_s3_bucket.target.bucket
+  key                = %[4]q
+  source             = "${aws_s3_bucket.source.bucket}/${aws_s3_object.source.key}"
+  checksum_algorithm = %[5]q
+}
+`, rName1, sourceKey, rName2, key, checksumAlgorithm)
+}
+
+func testAccObjectCopyConfig_checksumAlgorithmSource(rName1, sourceKey, rName2, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "source" {
+  bucket             = aws_s3_bucket.source.bucket
+  key                = %[2]q
+  content            = %[5]q
+  checksum_algorithm = "SHA256"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = %[3]q
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket             = aws_s3_bucket.target.bucket
+  key                = %[4]q
+  source             = "${aws_s3_bucket.source.bucket}/${aws_s3_object.source.key}"
+  checksum_algorithm = "SHA256"
+}
+`, rName1, sourceKey, rName2, key, content)
+}
+
 func testAccObjectCopyConfig_BucketKeyEnabled_Bucket(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -37,6 +38,27 @@ func DataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumMode_Values(), false),
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content_disposition": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -61,6 +83,24 @@ func DataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"expected_checksum": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"expiration": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -142,6 +182,12 @@ func dataSourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("version_id"); ok {
 		input.VersionId = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("checksum_mode"); ok {
+		input.ChecksumMode = aws.String(v.(string))
+	}
+	if _, ok := d.GetOk("expected_checksum"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
 
 	versionText := ""
 	uniqueId := bucket + "/" + key
@@ -161,10 +207,30 @@ func dataSourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Received S3 object: %s", out)
 
+	if v, ok := d.GetOk("expected_checksum"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		algorithm := tfMap["algorithm"].(string)
+		expected := tfMap["value"].(string)
+
+		actual := headObjectChecksum(out, algorithm)
+
+		if actual == "" {
+			return fmt.Errorf("S3 Bucket (%s) Object (%s)%s has no %s checksum", bucket, key, versionText, algorithm)
+		}
+
+		if actual != expected {
+			return fmt.Errorf("S3 Bucket (%s) Object (%s)%s %s checksum (%s) does not match expected checksum (%s)", bucket, key, versionText, algorithm, actual, expected)
+		}
+	}
+
 	d.SetId(uniqueId)
 
 	d.Set("bucket_key_enabled", out.BucketKeyEnabled)
 	d.Set("cache_control", out.CacheControl)
+	d.Set("checksum_crc32", out.ChecksumCRC32)
+	d.Set("checksum_crc32c", out.ChecksumCRC32C)
+	d.Set("checksum_sha1", out.ChecksumSHA1)
+	d.Set("checksum_sha256", out.ChecksumSHA256)
 	d.Set("content_disposition", out.ContentDisposition)
 	d.Set("content_encoding", out.ContentEncoding)
 	d.Set("content_language", out.ContentLanguage)
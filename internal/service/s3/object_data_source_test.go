@@ -51,6 +51,52 @@ func TestAccS3ObjectDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_checksumMode(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_checksumMode(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum_sha256", resourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_mode", s3.ChecksumModeEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_expectedChecksum(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_expectedChecksum(rName, "nv/y+81/+gPqBBdRZzctlwYpoup/wA77CIGd9Vf5LZc="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_sha256", "nv/y+81/+gPqBBdRZzctlwYpoup/wA77CIGd9Vf5LZc="),
+				),
+			},
+			{
+				Config:      testAccObjectDataSourceConfig_expectedChecksum(rName, "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg="),
+				ExpectError: regexp.MustCompile(`does not match expected checksum`),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_basicViaAccessPoint(t *testing.T) {
 	var dsObj, rObj s3.GetObjectOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -476,6 +522,52 @@ data "aws_s3_object" "obj" {
 `, randInt)
 }
 
+func testAccObjectDataSourceConfig_checksumMode(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = %[1]q
+  content            = "checksum content"
+  checksum_algorithm = "SHA256"
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = aws_s3_object.test.key
+  checksum_mode = "ENABLED"
+}
+`, rName)
+}
+
+func testAccObjectDataSourceConfig_expectedChecksum(rName, checksum string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = %[1]q
+  content            = "checksum content"
+  checksum_algorithm = "SHA256"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object.test.key
+
+  expected_checksum {
+    algorithm = "SHA256"
+    value     = %[2]q
+  }
+}
+`, rName, checksum)
+}
+
 func testAccObjectDataSourceConfig_basicViaAccessPoint(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
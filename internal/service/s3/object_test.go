@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -141,6 +142,75 @@ func TestAccS3Object_content(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectChecksumAlgorithmConfig(rName, s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					testAccCheckObjectBody(&obj, "checksum content"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmSha256),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "nv/y+81/+gPqBBdRZzctlwYpoup/wA77CIGd9Vf5LZc="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "checksum_algorithm", "checksum_sha256", "content", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectChecksumAlgorithmConfig(rName, s3.ChecksumAlgorithmCrc32c),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmCrc32c),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "YKwsKg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumAlgorithmMultipart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// Larger than a single 5 MiB part.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("a", 5*1024*1024+1))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectChecksumAlgorithmSourceConfig(rName, source, s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmSha256),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexp.MustCompile(`-2$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_etagEncryption(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
@@ -1628,6 +1698,36 @@ resource "aws_s3_object" "object" {
 `, rName, content)
 }
 
+func testAccObjectChecksumAlgorithmConfig(rName, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = "checksum content"
+  checksum_algorithm = %[2]q
+}
+`, rName, checksumAlgorithm)
+}
+
+func testAccObjectChecksumAlgorithmSourceConfig(rName, source, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = %[3]q
+}
+`, rName, source, checksumAlgorithm)
+}
+
 func testAccObjectEtagEncryption(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
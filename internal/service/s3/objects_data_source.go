@@ -2,14 +2,29 @@ package s3
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
-const keyRequestPageSize = 1000
+const (
+	keyRequestPageSize = 1000
+
+	// fetchAllKeysLimit is the maximum number of matching keys returned when
+	// fetch_all is set, guarding against unbounded listings of large buckets.
+	fetchAllKeysLimit = 100000
+
+	// listedKeysLimit is the maximum number of keys and common prefixes listed
+	// from the bucket, whether or not they match the filters, so that
+	// selective filters cannot page through an unbounded bucket.
+	listedKeysLimit = 1000000
+)
 
 func DataSourceObjects() *schema.Resource {
 	return &schema.Resource{
@@ -33,18 +48,55 @@ func DataSourceObjects() *schema.Resource {
 				Optional: true,
 			},
 			"max_keys": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  1000,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Default:       1000,
+				ConflictsWith: []string{"fetch_all"},
 			},
 			"start_after": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fetch_all": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"max_keys"},
+			},
 			"fetch_owner": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"include_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"min_age": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					duration, err := time.ParseDuration(value)
+					if err != nil {
+						errors = append(errors, fmt.Errorf(
+							"%q cannot be parsed as a duration: %w", k, err))
+					}
+					if duration < 0 {
+						errors = append(errors, fmt.Errorf(
+							"%q must not be negative", k))
+					}
+					return
+				},
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -55,6 +107,38 @@ func DataSourceObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -86,14 +170,6 @@ func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
 		listInput.EncodingType = aws.String(s.(string))
 	}
 
-	// "listInput.MaxKeys" refers to max keys returned in a single request
-	// (i.e., page size), not the total number of keys returned if you page
-	// through the results. "maxKeys" does refer to total keys returned.
-	maxKeys := int64(d.Get("max_keys").(int))
-	if maxKeys <= keyRequestPageSize {
-		listInput.MaxKeys = aws.Int64(maxKeys)
-	}
-
 	if s, ok := d.GetOk("start_after"); ok {
 		listInput.StartAfter = aws.String(s.(string))
 	}
@@ -102,36 +178,100 @@ func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
 		listInput.FetchOwner = aws.Bool(b.(bool))
 	}
 
+	filter := &objectsFilter{}
+
+	if v, ok := d.GetOk("key_regex"); ok {
+		filter.keyRegex = regexp.MustCompile(v.(string))
+	}
+
+	if v, ok := d.GetOk("min_age"); ok {
+		minAge, _ := time.ParseDuration(v.(string))
+		filter.modifiedBefore = time.Now().Add(-minAge)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		filter.storageClass = v.(string)
+	}
+
+	// "listInput.MaxKeys" refers to max keys returned in a single request
+	// (i.e., page size), not the total number of keys returned if you page
+	// through the results. "maxKeys" does refer to total keys returned,
+	// counting only the keys that pass the filter.
+	maxKeys := int64(d.Get("max_keys").(int))
+	fetchAll := d.Get("fetch_all").(bool)
+	if fetchAll {
+		maxKeys = fetchAllKeysLimit + 1
+	}
+	// Without a filter every listed key is returned, so the page size can be
+	// capped at the remaining budget.
+	if filter.isEmpty() && maxKeys <= keyRequestPageSize {
+		listInput.MaxKeys = aws.Int64(maxKeys)
+	}
+
+	includeMetadata := d.Get("include_metadata").(bool)
+
 	var commonPrefixes []string
 	var keys []string
+	var objects []interface{}
 	var owners []string
+	var listedKeys int64
+	listedKeysLimitReached := false
 
 	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
 			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
 		}
 
+		maxKeys = maxKeys - int64(len(page.CommonPrefixes))
+
 		for _, object := range page.Contents {
+			if !filter.match(object) {
+				continue
+			}
+
+			if maxKeys <= 0 {
+				break
+			}
+
 			keys = append(keys, aws.StringValue(object.Key))
 
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
 			}
-		}
 
-		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
+			if includeMetadata {
+				objects = append(objects, flattenObject(object))
+			}
+
+			maxKeys--
+		}
 
-		if maxKeys <= keyRequestPageSize {
+		if filter.isEmpty() && maxKeys <= keyRequestPageSize {
 			listInput.MaxKeys = aws.Int64(maxKeys)
 		}
 
-		return !lastPage
+		listedKeys += int64(len(page.Contents) + len(page.CommonPrefixes))
+
+		if !lastPage && maxKeys > 0 && listedKeys >= listedKeysLimit {
+			listedKeysLimitReached = true
+			return false
+		}
+
+		return !lastPage && maxKeys > 0
 	})
 
 	if err != nil {
 		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
 	}
 
+	if listedKeysLimitReached {
+		return fmt.Errorf("error listing S3 Bucket (%s) Objects: more than %d keys listed, narrow the listing with prefix or start_after", bucket, listedKeysLimit)
+	}
+
+	if fetchAll && len(keys)+len(commonPrefixes) > fetchAllKeysLimit {
+		return fmt.Errorf("error listing S3 Bucket (%s) Objects: more than %d keys match, narrow the listing with prefix, start_after or key_regex", bucket, fetchAllKeysLimit)
+	}
+
 	d.SetId(bucket)
 
 	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
@@ -142,9 +282,64 @@ func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting keys: %w", err)
 	}
 
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("error setting objects: %w", err)
+	}
+
 	if err := d.Set("owners", owners); err != nil {
 		return fmt.Errorf("error setting owners: %w", err)
 	}
 
 	return nil
 }
+
+// objectsFilter selects the listed objects returned by the data source.
+// Unset fields match every object.
+type objectsFilter struct {
+	keyRegex       *regexp.Regexp
+	modifiedBefore time.Time
+	storageClass   string
+}
+
+func (f *objectsFilter) isEmpty() bool {
+	return f.keyRegex == nil && f.modifiedBefore.IsZero() && f.storageClass == ""
+}
+
+func (f *objectsFilter) match(object *s3.Object) bool {
+	if object == nil {
+		return false
+	}
+
+	if f.keyRegex != nil && !f.keyRegex.MatchString(aws.StringValue(object.Key)) {
+		return false
+	}
+
+	if !f.modifiedBefore.IsZero() && (object.LastModified == nil || object.LastModified.After(f.modifiedBefore)) {
+		return false
+	}
+
+	if f.storageClass != "" && aws.StringValue(object.StorageClass) != f.storageClass {
+		return false
+	}
+
+	return true
+}
+
+func flattenObject(object *s3.Object) map[string]interface{} {
+	m := map[string]interface{}{
+		"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+		"key":           aws.StringValue(object.Key),
+		"size":          int(aws.Int64Value(object.Size)),
+		"storage_class": aws.StringValue(object.StorageClass),
+	}
+
+	if object.LastModified != nil {
+		m["last_modified"] = aws.TimeValue(object.LastModified).Format(time.RFC3339)
+	}
+
+	if object.Owner != nil {
+		m["owner"] = aws.StringValue(object.Owner.ID)
+	}
+
+	return m
+}
@@ -0,0 +1,83 @@
+package s3
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestObjectsFilterMatch(t *testing.T) {
+	now := time.Now()
+	object := &s3.Object{
+		Key:          aws.String("releases/app-1.2.3.zip"),
+		LastModified: aws.Time(now.Add(-48 * time.Hour)),
+		StorageClass: aws.String(s3.ObjectStorageClassStandard),
+	}
+
+	testCases := []struct {
+		name     string
+		filter   *objectsFilter
+		object   *s3.Object
+		expected bool
+	}{
+		{
+			name:     "nil object",
+			filter:   &objectsFilter{},
+			object:   nil,
+			expected: false,
+		},
+		{
+			name:     "empty filter",
+			filter:   &objectsFilter{},
+			object:   object,
+			expected: true,
+		},
+		{
+			name:     "key regex match",
+			filter:   &objectsFilter{keyRegex: regexp.MustCompile(`\.zip$`)},
+			object:   object,
+			expected: true,
+		},
+		{
+			name:     "key regex no match",
+			filter:   &objectsFilter{keyRegex: regexp.MustCompile(`\.tar\.gz$`)},
+			object:   object,
+			expected: false,
+		},
+		{
+			name:     "old enough",
+			filter:   &objectsFilter{modifiedBefore: now.Add(-24 * time.Hour)},
+			object:   object,
+			expected: true,
+		},
+		{
+			name:     "too recent",
+			filter:   &objectsFilter{modifiedBefore: now.Add(-72 * time.Hour)},
+			object:   object,
+			expected: false,
+		},
+		{
+			name:     "storage class match",
+			filter:   &objectsFilter{storageClass: s3.ObjectStorageClassStandard},
+			object:   object,
+			expected: true,
+		},
+		{
+			name:     "storage class no match",
+			filter:   &objectsFilter{storageClass: s3.ObjectStorageClassGlacier},
+			object:   object,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := testCase.filter.match(testCase.object); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
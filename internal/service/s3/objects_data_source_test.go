@@ -226,6 +226,109 @@ func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectsDataSource_fetchAll(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsFetchAllDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_includeMetadata(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsIncludeMetadataDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "objects.0.key", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttrPair("data.aws_s3_objects.yesh", "objects.0.etag", "aws_s3_object.object3", "etag"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "objects.0.size", "13"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "objects.0.storage_class", s3.ObjectStorageClassStandard),
+					resource.TestCheckResourceAttrSet("data.aws_s3_objects.yesh", "objects.0.last_modified"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "objects.1.key", "arch/navajo/sand_dune"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_filters(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsKeyRegexDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.0", "arch/three_gossips/broken"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.1", "arch/three_gossips/turret"),
+				),
+			},
+			{
+				Config: testAccObjectsKeyRegexMaxKeysDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.0", "arch/three_gossips/broken"),
+				),
+			},
+			{
+				Config: testAccObjectsMinAgeDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "0"),
+				),
+			},
+			{
+				Config: testAccObjectsStorageClassDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "7"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectsExistsDataSource(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -401,3 +504,71 @@ data "aws_s3_objects" "yesh" {
 }
 `, testAccObjectsResourcesDataSourceConfig(randInt))
 }
+
+func testAccObjectsFetchAllDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket    = aws_s3_bucket.objects_bucket.id
+  fetch_all = true
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsIncludeMetadataDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket           = aws_s3_bucket.objects_bucket.id
+  prefix           = "arch/navajo/"
+  include_metadata = true
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsKeyRegexDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket    = aws_s3_bucket.objects_bucket.id
+  key_regex = "gossips/(broken|turret)$"
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsKeyRegexMaxKeysDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket    = aws_s3_bucket.objects_bucket.id
+  key_regex = "gossips/(broken|turret)$"
+  max_keys  = 1
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsMinAgeDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket  = aws_s3_bucket.objects_bucket.id
+  min_age = "24h"
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsStorageClassDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket        = aws_s3_bucket.objects_bucket.id
+  storage_class = "STANDARD"
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
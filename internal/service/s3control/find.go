@@ -263,3 +263,55 @@ func FindObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn *s3contro
 
 	return policy, output2.PolicyStatus, nil
 }
+
+func FindStorageLensConfigurationByAccountIDAndConfigID(conn *s3control.S3Control, accountID string, configID string) (*s3control.StorageLensConfiguration, error) {
+	input := &s3control.GetStorageLensConfigurationInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	}
+
+	output, err := conn.GetStorageLensConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageLensConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageLensConfiguration, nil
+}
+
+func FindJobByAccountIDAndJobID(conn *s3control.S3Control, accountID string, jobID string) (*s3control.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(input)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
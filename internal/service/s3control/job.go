@@ -0,0 +1,1282 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobCreate,
+		Read:   resourceJobRead,
+		Update: resourceJobUpdate,
+		Delete: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirm": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.JobManifestFieldName_Values(), false),
+										},
+									},
+									"format": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.JobManifestFormat_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3GlacierJobTier_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3CannedAccessControlList_Values(), false),
+									},
+									"checksum_algorithm": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ChecksumAlgorithm_Values(), false),
+									},
+									"metadata_directive": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3MetadataDirective_Values(), false),
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"sse_algorithm": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(s3control.S3SSEAlgorithm_Values(), false),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3StorageClass_Values(), false),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_retention": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"retention": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockRetentionMode_Values(), false),
+												},
+												"retain_until_date": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportFormat_Values(), false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportScope_Values(), false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var jobOperationKeys = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_retention",
+	"operation.0.s3_put_object_tagging",
+}
+
+func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		operation, err := expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		input.Operation = operation
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Batch Operations Job: %s", input)
+	output, err := conn.CreateJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Batch Operations Job: %w", err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+	d.SetId(JobCreateResourceID(accountID, jobID))
+
+	job, err := waitJobPrepared(conn, accountID, jobID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) to be prepared: %w", d.Id(), err)
+	}
+
+	if jobAwaitingConfirmation(job) {
+		if !d.Get("confirm").(bool) {
+			return resourceJobRead(d, meta)
+		}
+
+		if err := confirmJob(conn, accountID, jobID); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitJobCompleted(conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Job records are only retained for 90 days after the job finishes.
+		// Keep the last known state rather than re-running a finished job.
+		if jobStatusTerminal(d.Get("status").(string)) {
+			log.Printf("[WARN] S3 Batch Operations Job (%s) record has expired, keeping last known state", d.Id())
+			return nil
+		}
+
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	if err := d.Set("failure_reasons", flattenJobFailures(job.FailureReasons)); err != nil {
+		return fmt.Errorf("error setting failure_reasons: %w", err)
+	}
+	d.Set("job_id", job.JobId)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return fmt.Errorf("error setting manifest: %w", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(job.Operation)}); err != nil {
+			return fmt.Errorf("error setting operation: %w", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", job.Priority)
+	if job.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(job.ProgressSummary)}); err != nil {
+			return fmt.Errorf("error setting progress_summary: %w", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return fmt.Errorf("error setting report: %w", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+
+	tags, err := jobListTags(conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Batch Operations Job priority: %s", input)
+		_, err := conn.UpdateJobPriority(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Batch Operations Job (%s) priority: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := jobUpdateTags(conn, accountID, jobID, o, n); err != nil {
+			return fmt.Errorf("error updating S3 Batch Operations Job (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("confirm") && d.Get("confirm").(bool) {
+		job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+		}
+
+		if jobAwaitingConfirmation(job) {
+			if err := confirmJob(conn, accountID, jobID); err != nil {
+				return err
+			}
+
+			if d.Get("wait_for_completion").(bool) {
+				if _, err := waitJobCompleted(conn, accountID, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) to complete: %w", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	// Jobs cannot be deleted. Cancel any job that has not yet finished.
+	if jobStatusTerminal(aws.StringValue(job.Status)) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatus(&s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Cancelled by Terraform"),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException, s3control.ErrCodeJobStatusException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) to be cancelled: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceJobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("confirm", false)
+	d.Set("wait_for_completion", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func confirmJob(conn *s3control.S3Control, accountID, jobID string) error {
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusReady),
+	}
+
+	log.Printf("[DEBUG] Confirming S3 Batch Operations Job: %s", input)
+	_, err := conn.UpdateJobStatus(input)
+
+	if err != nil {
+		return fmt.Errorf("error confirming S3 Batch Operations Job (%s): %w", jobID, err)
+	}
+
+	return nil
+}
+
+// jobAwaitingConfirmation returns whether the job is suspended until it is confirmed.
+func jobAwaitingConfirmation(job *s3control.JobDescriptor) bool {
+	return aws.BoolValue(job.ConfirmationRequired) && aws.StringValue(job.Status) == s3control.JobStatusSuspended
+}
+
+func jobStatusTerminal(status string) bool {
+	switch status {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+const jobResourceIDSeparator = ":"
+
+func JobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func JobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, jobResourceIDSeparator)
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *s3control.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *s3control.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *s3control.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		for _, field := range v {
+			apiObject.Fields = append(apiObject.Fields, aws.String(field.(string)))
+		}
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) (*s3control.JobOperation, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &s3control.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaInvoke = expandLambdaInvokeOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 {
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(tfMap)
+		} else {
+			apiObject.S3InitiateRestoreObject = &s3control.S3InitiateRestoreObjectOperation{}
+		}
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		operation, err := expandS3SetObjectRetentionOperation(v[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.S3PutObjectRetention = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			apiObject.S3PutObjectTagging = expandS3SetObjectTaggingOperation(tfMap)
+		} else {
+			// An empty tag set removes all tags from the objects.
+			apiObject.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{TagSet: []*s3control.S3Tag{}}
+		}
+	}
+
+	return apiObject, nil
+}
+
+func expandLambdaInvokeOperation(tfMap map[string]interface{}) *s3control.LambdaInvokeOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.LambdaInvokeOperation{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *s3control.S3InitiateRestoreObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3InitiateRestoreObjectOperation{}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+		apiObject.ExpirationInDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *s3control.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v {
+		apiObject.BucketKeyEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = aws.String(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = aws.String(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = Tags(tftags.New(v))
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *s3control.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = make(map[string]*string, len(v))
+
+		for k, v := range v {
+			apiObject.UserMetadata[k] = aws.String(v.(string))
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectRetentionOperation(tfMap map[string]interface{}) (*s3control.S3SetObjectRetentionOperation, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &s3control.S3SetObjectRetentionOperation{}
+
+	if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+		apiObject.BypassGovernanceRetention = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["retention"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Retention = &s3control.S3Retention{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["mode"].(string); ok && v != "" {
+				apiObject.Retention.Mode = aws.String(v)
+			}
+
+			if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+				t, err := time.Parse(time.RFC3339, v)
+
+				if err != nil {
+					return nil, fmt.Errorf("error parsing retain_until_date (%s): %w", v, err)
+				}
+
+				apiObject.Retention.RetainUntilDate = aws.Time(t)
+			}
+		}
+	}
+
+	return apiObject, nil
+}
+
+func expandS3SetObjectTaggingOperation(tfMap map[string]interface{}) *s3control.S3SetObjectTaggingOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3SetObjectTaggingOperation{
+		TagSet: []*s3control.S3Tag{},
+	}
+
+	if v, ok := tfMap["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagSet = Tags(tftags.New(v))
+	}
+
+	return apiObject
+}
+
+func expandJobReport(tfMap map[string]interface{}) *s3control.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobFailures(apiObjects []*s3control.JobFailure) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code":   aws.StringValue(apiObject.FailureCode),
+			"failure_reason": aws.StringValue(apiObject.FailureReason),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobManifest(apiObject *s3control.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{flattenJobManifestLocation(v)}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{flattenJobManifestSpec(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestLocation(apiObject *s3control.JobManifestLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ETag; v != nil {
+		tfMap["etag"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ObjectArn; v != nil {
+		tfMap["object_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ObjectVersionId; v != nil {
+		tfMap["object_version_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestSpec(apiObject *s3control.JobManifestSpec) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Fields; v != nil {
+		tfMap["fields"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Format; v != nil {
+		tfMap["format"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(apiObject *s3control.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			"function_arn": aws.StringValue(v.FunctionArn),
+		}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.Int64Value(v.ExpirationInDays),
+			"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+		}}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectRetention; v != nil {
+		tfMap["s3_put_object_retention"] = []interface{}{flattenS3SetObjectRetentionOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": KeyValueTags(v.TagSet).Map(),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(apiObject *s3control.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_key_enabled":         aws.BoolValue(apiObject.BucketKeyEnabled),
+		"canned_access_control_list": aws.StringValue(apiObject.CannedAccessControlList),
+		"checksum_algorithm":         aws.StringValue(apiObject.ChecksumAlgorithm),
+		"metadata_directive":         aws.StringValue(apiObject.MetadataDirective),
+		"sse_aws_kms_key_id":         aws.StringValue(apiObject.SSEAwsKmsKeyId),
+		"storage_class":              aws.StringValue(apiObject.StorageClass),
+		"target_key_prefix":          aws.StringValue(apiObject.TargetKeyPrefix),
+		"target_resource":            aws.StringValue(apiObject.TargetResource),
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		tfMap["new_object_metadata"] = []interface{}{map[string]interface{}{
+			"cache_control": aws.StringValue(v.CacheControl),
+			"content_type":  aws.StringValue(v.ContentType),
+			"sse_algorithm": aws.StringValue(v.SSEAlgorithm),
+			"user_metadata": aws.StringValueMap(v.UserMetadata),
+		}}
+	}
+
+	if v := apiObject.NewObjectTagging; v != nil {
+		tfMap["new_object_tagging"] = KeyValueTags(v).Map()
+	}
+
+	return tfMap
+}
+
+func flattenS3SetObjectRetentionOperation(apiObject *s3control.S3SetObjectRetentionOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bypass_governance_retention": aws.BoolValue(apiObject.BypassGovernanceRetention),
+	}
+
+	if v := apiObject.Retention; v != nil {
+		retention := map[string]interface{}{
+			"mode": aws.StringValue(v.Mode),
+		}
+
+		if v := v.RetainUntilDate; v != nil {
+			retention["retain_until_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfMap["retention"] = []interface{}{retention}
+	}
+
+	return tfMap
+}
+
+func flattenJobProgressSummary(apiObject *s3control.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"number_of_tasks_failed":    aws.Int64Value(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.Int64Value(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.Int64Value(apiObject.TotalNumberOfTasks),
+	}
+}
+
+func flattenJobReport(apiObject *s3control.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Format; v != nil {
+		tfMap["format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ReportScope; v != nil {
+		tfMap["report_scope"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,435 @@
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+)
+
+func TestAccS3ControlJob_basic(t *testing.T) {
+	var v s3control.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "confirm", "false"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "failure_reasons.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest.0.location.0.etag", "aws_s3_object.manifest", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", s3control.JobManifestFormatS3batchOperationsCsv20180820),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Processed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_failed", "0"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_confirmation(t *testing.T) {
+	var v s3control.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfirmationConfig(rName, false, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confirm", "false"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusSuspended),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfirmationConfig(rName, false, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusSuspended),
+				),
+			},
+			{
+				Config: testAccJobConfirmationConfig(rName, true, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confirm", "true"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_tags(t *testing.T) {
+	var v s3control.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccJobTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// Jobs cannot be deleted, so check that any unfinished job was cancelled.
+func testAccCheckJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		accountID, jobID, err := tfs3control.JobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		switch status := *output.Status; status {
+		case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		default:
+			return fmt.Errorf("S3 Batch Operations Job %s still in status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckJobExists(n string, v *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Batch Operations Job ID is set")
+		}
+
+		accountID, jobID, err := tfs3control.JobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "data" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data.txt"
+  content = "data"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.bucket},${aws_s3_object.data.key}"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+      "s3:GetObject",
+      "s3:GetObjectVersion",
+      "s3:PutObjectTagging",
+      "s3:PutObjectVersionTagging"
+    ],
+    "Resource": "${aws_s3_bucket.test.arn}/*"
+  }]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccJobConfig(rName string) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  description = %[1]q
+  priority    = 10
+  role_arn    = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccJobConfirmationConfig(rName string, confirm bool, priority int) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirm               = %[2]t
+  confirmation_required = true
+  priority              = %[3]d
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = %[1]q
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, confirm, priority))
+}
+
+func testAccJobTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccJobTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,1067 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStorageLensConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageLensConfigurationCreate,
+		Read:   resourceStorageLensConfigurationRead,
+		Update: resourceStorageLensConfigurationUpdate,
+		Delete: resourceStorageLensConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-_.]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"storage_lens_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_level": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"activity_metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"bucket_level": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"activity_metrics": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enabled": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"prefix_level": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"storage_metrics": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"enabled": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																		},
+																		"selection_criteria": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"delimiter": {
+																						Type:     schema.TypeString,
+																						Optional: true,
+																					},
+																					"max_depth": {
+																						Type:         schema.TypeInt,
+																						Optional:     true,
+																						ValidateFunc: validation.IntBetween(1, 10),
+																					},
+																					"min_storage_bytes_percentage": {
+																						Type:         schema.TypeFloat,
+																						Optional:     true,
+																						ValidateFunc: validation.FloatBetween(1.0, 100.0),
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"aws_org": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"data_export": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloud_watch_metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+									"s3_bucket_destination": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"encryption": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"sse_kms": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"key_id": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: verify.ValidARN,
+																		},
+																	},
+																},
+															},
+															"sse_s3": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{},
+																},
+															},
+														},
+													},
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3control.Format_Values(), false),
+												},
+												"output_schema_version": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3control.OutputSchemaVersion_Values(), false),
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"exclude": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage_lens_configuration.0.include"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"buckets": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+								},
+							},
+						},
+						"include": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage_lens_configuration.0.exclude"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"buckets": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStorageLensConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	configID := d.Get("config_id").(string)
+	resourceID := StorageLensConfigurationCreateResourceID(accountID, configID)
+
+	input := &s3control.PutStorageLensConfigurationInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	}
+
+	if v, ok := d.GetOk("storage_lens_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StorageLensConfiguration = expandStorageLensConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		input.StorageLensConfiguration.Id = aws.String(configID)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = StorageLensTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Storage Lens Configuration: %s", input)
+	_, err := conn.PutStorageLensConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Storage Lens Configuration (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceStorageLensConfigurationRead(d, meta)
+}
+
+func resourceStorageLensConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID, configID, err := StorageLensConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindStorageLensConfigurationByAccountIDAndConfigID(conn, accountID, configID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Storage Lens Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", output.StorageLensArn)
+	d.Set("config_id", configID)
+	if err := d.Set("storage_lens_configuration", []interface{}{flattenStorageLensConfiguration(output)}); err != nil {
+		return fmt.Errorf("error setting storage_lens_configuration: %w", err)
+	}
+
+	tags, err := storageLensConfigurationListTags(conn, accountID, configID)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceStorageLensConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, configID, err := StorageLensConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("storage_lens_configuration") {
+		input := &s3control.PutStorageLensConfigurationInput{
+			AccountId: aws.String(accountID),
+			ConfigId:  aws.String(configID),
+		}
+
+		if v, ok := d.GetOk("storage_lens_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.StorageLensConfiguration = expandStorageLensConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			input.StorageLensConfiguration.Id = aws.String(configID)
+		}
+
+		// PutStorageLensConfiguration replaces the configuration's tags when any are specified.
+		if tags := tftags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+			input.Tags = StorageLensTags(tags.IgnoreAWS())
+		}
+
+		log.Printf("[DEBUG] Updating S3 Storage Lens Configuration: %s", input)
+		_, err := conn.PutStorageLensConfiguration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := storageLensConfigurationUpdateTags(conn, accountID, configID, o, n); err != nil {
+			return fmt.Errorf("error updating S3 Storage Lens Configuration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceStorageLensConfigurationRead(d, meta)
+}
+
+func resourceStorageLensConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, configID, err := StorageLensConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Storage Lens Configuration: %s", d.Id())
+	_, err = conn.DeleteStorageLensConfiguration(&s3control.DeleteStorageLensConfigurationInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const storageLensConfigurationResourceIDSeparator = ":"
+
+func StorageLensConfigurationCreateResourceID(accountID, configID string) string {
+	parts := []string{accountID, configID}
+	id := strings.Join(parts, storageLensConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func StorageLensConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, storageLensConfigurationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sconfiguration-id", id, storageLensConfigurationResourceIDSeparator)
+}
+
+func expandStorageLensConfiguration(tfMap map[string]interface{}) *s3control.StorageLensConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.StorageLensConfiguration{}
+
+	if v, ok := tfMap["account_level"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccountLevel = expandAccountLevel(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["aws_org"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AwsOrg = expandStorageLensAwsOrg(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["data_export"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataExport = expandStorageLensDataExport(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.IsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["exclude"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Exclude = expandExclude(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["include"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Include = expandInclude(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAccountLevel(tfMap map[string]interface{}) *s3control.AccountLevel {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.AccountLevel{}
+
+	if v, ok := tfMap["activity_metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ActivityMetrics = expandActivityMetrics(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["bucket_level"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BucketLevel = expandBucketLevel(v[0].(map[string]interface{}))
+	} else {
+		apiObject.BucketLevel = &s3control.BucketLevel{}
+	}
+
+	return apiObject
+}
+
+func expandActivityMetrics(tfMap map[string]interface{}) *s3control.ActivityMetrics {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.ActivityMetrics{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.IsEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandBucketLevel(tfMap map[string]interface{}) *s3control.BucketLevel {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.BucketLevel{}
+
+	if v, ok := tfMap["activity_metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ActivityMetrics = expandActivityMetrics(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["prefix_level"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PrefixLevel = expandPrefixLevel(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPrefixLevel(tfMap map[string]interface{}) *s3control.PrefixLevel {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.PrefixLevel{}
+
+	if v, ok := tfMap["storage_metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StorageMetrics = expandPrefixLevelStorageMetrics(v[0].(map[string]interface{}))
+	} else {
+		apiObject.StorageMetrics = &s3control.PrefixLevelStorageMetrics{}
+	}
+
+	return apiObject
+}
+
+func expandPrefixLevelStorageMetrics(tfMap map[string]interface{}) *s3control.PrefixLevelStorageMetrics {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.PrefixLevelStorageMetrics{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.IsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["selection_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SelectionCriteria = expandSelectionCriteria(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSelectionCriteria(tfMap map[string]interface{}) *s3control.SelectionCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.SelectionCriteria{}
+
+	if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+		apiObject.Delimiter = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_depth"].(int); ok && v != 0 {
+		apiObject.MaxDepth = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_storage_bytes_percentage"].(float64); ok && v != 0.0 {
+		apiObject.MinStorageBytesPercentage = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandStorageLensAwsOrg(tfMap map[string]interface{}) *s3control.StorageLensAwsOrg {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.StorageLensAwsOrg{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStorageLensDataExport(tfMap map[string]interface{}) *s3control.StorageLensDataExport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.StorageLensDataExport{}
+
+	if v, ok := tfMap["cloud_watch_metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchMetrics = expandCloudWatchMetrics(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_bucket_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3BucketDestination = expandS3BucketDestination(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchMetrics(tfMap map[string]interface{}) *s3control.CloudWatchMetrics {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.CloudWatchMetrics{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.IsEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandS3BucketDestination(tfMap map[string]interface{}) *s3control.S3BucketDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3BucketDestination{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		apiObject.AccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandStorageLensDataExportEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_schema_version"].(string); ok && v != "" {
+		apiObject.OutputSchemaVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStorageLensDataExportEncryption(tfMap map[string]interface{}) *s3control.StorageLensDataExportEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.StorageLensDataExportEncryption{}
+
+	if v, ok := tfMap["sse_kms"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SSEKMS = expandSSEKMS(v[0].(map[string]interface{}))
+	}
+
+	// The SSE-S3 configuration block has no arguments.
+	if v, ok := tfMap["sse_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SSES3 = &s3control.SSES3{}
+	}
+
+	return apiObject
+}
+
+func expandSSEKMS(tfMap map[string]interface{}) *s3control.SSEKMS {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.SSEKMS{}
+
+	if v, ok := tfMap["key_id"].(string); ok && v != "" {
+		apiObject.KeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandExclude(tfMap map[string]interface{}) *s3control.Exclude {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.Exclude{}
+
+	if v, ok := tfMap["buckets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Buckets = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandInclude(tfMap map[string]interface{}) *s3control.Include {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.Include{}
+
+	if v, ok := tfMap["buckets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Buckets = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenStorageLensConfiguration(apiObject *s3control.StorageLensConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccountLevel; v != nil {
+		tfMap["account_level"] = []interface{}{flattenAccountLevel(v)}
+	}
+
+	if v := apiObject.AwsOrg; v != nil {
+		tfMap["aws_org"] = []interface{}{flattenStorageLensAwsOrg(v)}
+	}
+
+	if v := apiObject.DataExport; v != nil {
+		tfMap["data_export"] = []interface{}{flattenStorageLensDataExport(v)}
+	}
+
+	if v := apiObject.IsEnabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Exclude; v != nil {
+		tfMap["exclude"] = []interface{}{flattenExclude(v)}
+	}
+
+	if v := apiObject.Include; v != nil {
+		tfMap["include"] = []interface{}{flattenInclude(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAccountLevel(apiObject *s3control.AccountLevel) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ActivityMetrics; v != nil {
+		tfMap["activity_metrics"] = []interface{}{flattenActivityMetrics(v)}
+	}
+
+	if v := apiObject.BucketLevel; v != nil {
+		tfMap["bucket_level"] = []interface{}{flattenBucketLevel(v)}
+	}
+
+	return tfMap
+}
+
+func flattenActivityMetrics(apiObject *s3control.ActivityMetrics) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IsEnabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenBucketLevel(apiObject *s3control.BucketLevel) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ActivityMetrics; v != nil {
+		tfMap["activity_metrics"] = []interface{}{flattenActivityMetrics(v)}
+	}
+
+	if v := apiObject.PrefixLevel; v != nil {
+		tfMap["prefix_level"] = []interface{}{flattenPrefixLevel(v)}
+	}
+
+	return tfMap
+}
+
+func flattenPrefixLevel(apiObject *s3control.PrefixLevel) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.StorageMetrics; v != nil {
+		tfMap["storage_metrics"] = []interface{}{flattenPrefixLevelStorageMetrics(v)}
+	}
+
+	return tfMap
+}
+
+func flattenPrefixLevelStorageMetrics(apiObject *s3control.PrefixLevelStorageMetrics) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IsEnabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.SelectionCriteria; v != nil {
+		tfMap["selection_criteria"] = []interface{}{flattenSelectionCriteria(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSelectionCriteria(apiObject *s3control.SelectionCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Delimiter; v != nil {
+		tfMap["delimiter"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MaxDepth; v != nil {
+		tfMap["max_depth"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinStorageBytesPercentage; v != nil {
+		tfMap["min_storage_bytes_percentage"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensAwsOrg(apiObject *s3control.StorageLensAwsOrg) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensDataExport(apiObject *s3control.StorageLensDataExport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchMetrics; v != nil {
+		tfMap["cloud_watch_metrics"] = []interface{}{flattenCloudWatchMetrics(v)}
+	}
+
+	if v := apiObject.S3BucketDestination; v != nil {
+		tfMap["s3_bucket_destination"] = []interface{}{flattenS3BucketDestination(v)}
+	}
+
+	return tfMap
+}
+
+func flattenCloudWatchMetrics(apiObject *s3control.CloudWatchMetrics) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IsEnabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3BucketDestination(apiObject *s3control.S3BucketDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccountId; v != nil {
+		tfMap["account_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{flattenStorageLensDataExportEncryption(v)}
+	}
+
+	if v := apiObject.Format; v != nil {
+		tfMap["format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutputSchemaVersion; v != nil {
+		tfMap["output_schema_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensDataExportEncryption(apiObject *s3control.StorageLensDataExportEncryption) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SSEKMS; v != nil {
+		tfMap["sse_kms"] = []interface{}{flattenSSEKMS(v)}
+	}
+
+	if v := apiObject.SSES3; v != nil {
+		tfMap["sse_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func flattenSSEKMS(apiObject *s3control.SSEKMS) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KeyId; v != nil {
+		tfMap["key_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenExclude(apiObject *s3control.Exclude) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Buckets; v != nil {
+		tfMap["buckets"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Regions; v != nil {
+		tfMap["regions"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenInclude(apiObject *s3control.Include) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Buckets; v != nil {
+		tfMap["buckets"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Regions; v != nil {
+		tfMap["regions"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
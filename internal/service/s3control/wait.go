@@ -3,6 +3,7 @@ package s3control
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +25,8 @@ const (
 	multiRegionAccessPointRequestSucceededMinTimeout = 5 * time.Second
 
 	multiRegionAccessPointRequestSucceededDelay = 15 * time.Second
+
+	jobStatusMinTimeout = 10 * time.Second
 )
 
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
@@ -119,3 +122,106 @@ func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accou
 
 	return nil, err
 }
+
+func waitJobPrepared(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{s3control.JobStatusNew, s3control.JobStatusPreparing},
+		Target: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelled,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusComplete,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		setJobLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCompleted(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+		},
+		Target:     []string{s3control.JobStatusComplete},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		setJobLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCancelled(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailing,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Target:     []string{s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setJobLastError(err error, job *s3control.JobDescriptor) {
+	var errs []string
+
+	for _, failure := range job.FailureReasons {
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureReason)))
+	}
+
+	if v := job.ProgressSummary; v != nil && aws.Int64Value(v.NumberOfTasksFailed) > 0 {
+		errs = append(errs, fmt.Sprintf("%d of %d tasks failed", aws.Int64Value(v.NumberOfTasksFailed), aws.Int64Value(v.TotalNumberOfTasks)))
+	}
+
+	if len(errs) > 0 {
+		tfresource.SetLastError(err, fmt.Errorf("%s", strings.Join(errs, "; ")))
+	}
+}
@@ -0,0 +1,226 @@
+package swf
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceActivityType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceActivityTypeCreate,
+		ReadWithoutTimeout:   resourceActivityTypeRead,
+		DeleteWithoutTimeout: resourceActivityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_task_heartbeat_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeName,
+			},
+			"default_task_priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTaskPriority,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.All(validation.StringLenBetween(1, 64), validTypeName),
+			},
+		},
+	}
+}
+
+func resourceActivityTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	id := TypeCreateResourceID(domain, name, version)
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering SWF Activity Type: %s", input)
+	_, err := conn.RegisterActivityTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error registering SWF Activity Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceActivityTypeRead(ctx, d, meta)
+}
+
+func resourceActivityTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindActivityTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.Configuration
+	typeInfo := output.TypeInfo
+
+	d.Set("creation_date", aws.TimeValue(typeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_task_heartbeat_timeout", configuration.DefaultTaskHeartbeatTimeout)
+	if configuration.DefaultTaskList != nil {
+		d.Set("default_task_list", configuration.DefaultTaskList.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", configuration.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", configuration.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", configuration.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", configuration.DefaultTaskStartToCloseTimeout)
+	d.Set("description", typeInfo.Description)
+	d.Set("domain", domain)
+	d.Set("name", typeInfo.ActivityType.Name)
+	d.Set("status", typeInfo.Status)
+	d.Set("version", typeInfo.ActivityType.Version)
+
+	return nil
+}
+
+func resourceActivityTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Activity Type: %s", d.Id())
+	_, err = conn.DeprecateActivityTypeWithContext(ctx, &swf.DeprecateActivityTypeInput{
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	})
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeTypeDeprecatedFault, swf.ErrCodeUnknownResourceFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deprecating SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
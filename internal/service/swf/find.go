@@ -0,0 +1,85 @@
+package swf
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindActivityTypeByThreePartKey(ctx context.Context, conn *swf.SWF, domain, name, version string) (*swf.DescribeActivityTypeOutput, error) {
+	input := &swf.DescribeActivityTypeInput{
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	}
+
+	output, err := conn.DescribeActivityTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeUnknownResourceFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deprecated types can still be described but can no longer be used.
+	if status := aws.StringValue(output.TypeInfo.Status); status == swf.RegistrationStatusDeprecated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindWorkflowTypeByThreePartKey(ctx context.Context, conn *swf.SWF, domain, name, version string) (*swf.DescribeWorkflowTypeOutput, error) {
+	input := &swf.DescribeWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	output, err := conn.DescribeWorkflowTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeUnknownResourceFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deprecated types can still be described but can no longer be used.
+	if status := aws.StringValue(output.TypeInfo.Status); status == swf.RegistrationStatusDeprecated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
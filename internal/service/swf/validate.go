@@ -0,0 +1,23 @@
+package swf
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validTimeout validates an SWF timeout, which is a duration in seconds or the literal "NONE".
+var validTimeout = validation.Any(
+	validation.StringInSlice([]string{"NONE"}, false),
+	validation.StringMatch(regexp.MustCompile(`^\d+$`), "must be a number of seconds or NONE"),
+)
+
+// validTypeName validates an SWF activity or workflow type name or version.
+var validTypeName = validation.All(
+	validation.StringLenBetween(1, 256),
+	validation.StringDoesNotMatch(regexp.MustCompile(`[:/|\x00-\x1f\x7f-\x9f]`), "must not contain ':', '/', '|' or control characters"),
+	validation.StringNotInSlice([]string{"arn"}, false),
+)
+
+// validTaskPriority validates an SWF task priority, which is a signed 32-bit integer.
+var validTaskPriority = validation.StringMatch(regexp.MustCompile(`^[+-]?\d{1,10}$`), "must be an integer")
@@ -0,0 +1,248 @@
+package swf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkflowType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkflowTypeCreate,
+		ReadWithoutTimeout:   resourceWorkflowTypeRead,
+		DeleteWithoutTimeout: resourceWorkflowTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_child_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(swf.ChildPolicy_Values(), false),
+			},
+			"default_execution_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_lambda_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeName,
+			},
+			"default_task_priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTaskPriority,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.All(validation.StringLenBetween(1, 64), validTypeName),
+			},
+		},
+	}
+}
+
+func resourceWorkflowTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	id := TypeCreateResourceID(domain, name, version)
+	input := &swf.RegisterWorkflowTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_child_policy"); ok {
+		input.DefaultChildPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_execution_start_to_close_timeout"); ok {
+		input.DefaultExecutionStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_lambda_role"); ok {
+		input.DefaultLambdaRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering SWF Workflow Type: %s", input)
+	_, err := conn.RegisterWorkflowTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error registering SWF Workflow Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceWorkflowTypeRead(ctx, d, meta)
+}
+
+func resourceWorkflowTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindWorkflowTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Workflow Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.Configuration
+	typeInfo := output.TypeInfo
+
+	d.Set("creation_date", aws.TimeValue(typeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_child_policy", configuration.DefaultChildPolicy)
+	d.Set("default_execution_start_to_close_timeout", configuration.DefaultExecutionStartToCloseTimeout)
+	d.Set("default_lambda_role", configuration.DefaultLambdaRole)
+	if configuration.DefaultTaskList != nil {
+		d.Set("default_task_list", configuration.DefaultTaskList.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", configuration.DefaultTaskPriority)
+	d.Set("default_task_start_to_close_timeout", configuration.DefaultTaskStartToCloseTimeout)
+	d.Set("description", typeInfo.Description)
+	d.Set("domain", domain)
+	d.Set("name", typeInfo.WorkflowType.Name)
+	d.Set("status", typeInfo.Status)
+	d.Set("version", typeInfo.WorkflowType.Version)
+
+	return nil
+}
+
+func resourceWorkflowTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Workflow Type: %s", d.Id())
+	_, err = conn.DeprecateWorkflowTypeWithContext(ctx, &swf.DeprecateWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeTypeDeprecatedFault, swf.ErrCodeUnknownResourceFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deprecating SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const typeResourceIDSeparator = ":"
+
+func TypeCreateResourceID(domain, name, version string) string {
+	parts := []string{domain, name, version}
+	id := strings.Join(parts, typeResourceIDSeparator)
+
+	return id
+}
+
+func TypeParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, typeResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain%[2]sname%[2]sversion", id, typeResourceIDSeparator)
+}
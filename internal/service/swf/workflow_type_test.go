@@ -0,0 +1,206 @@
+package swf_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/swf"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfswf "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSWFWorkflowType_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, swf.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "default_child_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_execution_start_to_close_timeout", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_lambda_role", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain", "aws_swf_domain.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", swf.RegistrationStatusRegistered),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFWorkflowType_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, swf.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowTypeExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfswf.ResourceWorkflowType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFWorkflowType_defaults(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, swf.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTypeConfig_defaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_child_policy", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "default_execution_start_to_close_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", "test-tasks"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_swf_workflow_type" {
+			continue
+		}
+
+		domain, name, version, err := tfswf.TypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfswf.FindWorkflowTypeByThreePartKey(context.TODO(), conn, domain, name, version)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SWF Workflow Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckWorkflowTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SWF Workflow Type ID is set")
+		}
+
+		domain, name, version, err := tfswf.TypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn
+
+		_, err = tfswf.FindWorkflowTypeByThreePartKey(context.TODO(), conn, domain, name, version)
+
+		return err
+	}
+}
+
+func testAccWorkflowTypeConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+`, rName)
+}
+
+func testAccWorkflowTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowTypeConfigBase(rName), fmt.Sprintf(`
+resource "aws_swf_workflow_type" "test" {
+  domain  = aws_swf_domain.test.name
+  name    = %[1]q
+  version = "1.0"
+}
+`, rName))
+}
+
+func testAccWorkflowTypeConfig_defaults(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowTypeConfigBase(rName), fmt.Sprintf(`
+resource "aws_swf_workflow_type" "test" {
+  domain      = aws_swf_domain.test.name
+  name        = %[1]q
+  version     = "1.0"
+  description = "test"
+
+  default_child_policy                     = "TERMINATE"
+  default_execution_start_to_close_timeout = "3600"
+  default_task_list                        = "test-tasks"
+  default_task_priority                    = "1"
+  default_task_start_to_close_timeout      = "300"
+}
+`, rName))
+}